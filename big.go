@@ -0,0 +1,130 @@
+package svalidator
+
+import (
+	"fmt"
+	"math/big"
+)
+
+type bigNumber[T any] interface {
+	*big.Int | *big.Rat | *big.Float
+	Cmp(y T) int
+	Sign() int
+	String() string
+}
+
+// BigValidator is a validator for *big.Int, *big.Rat and *big.Float.
+// Every rule except Required passes nil input, and rules with a nil bound panic.
+type BigValidator[T bigNumber[T]] struct {
+	*Validator[T]
+}
+
+type (
+	BigIntValidator   = BigValidator[*big.Int]
+	BigRatValidator   = BigValidator[*big.Rat]
+	BigFloatValidator = BigValidator[*big.Float]
+)
+
+// BigInt returns a validator for *big.Int.
+func BigInt() *BigIntValidator {
+	return &BigIntValidator{Validator: New[*big.Int]()}
+}
+
+// BigRat returns a validator for *big.Rat.
+func BigRat() *BigRatValidator {
+	return &BigRatValidator{Validator: New[*big.Rat]()}
+}
+
+// BigFloat returns a validator for *big.Float.
+func BigFloat() *BigFloatValidator {
+	return &BigFloatValidator{Validator: New[*big.Float]()}
+}
+
+// Min adds a validate whether input is greater than or equal to num.
+// The error is *ErrBound.
+func (b *BigValidator[T]) Min(num T) *BigValidator[T] {
+	return b.compare(func(c int) bool { return c >= 0 }, num, &ErrBound{Err: ErrTooSmall, Bound: bigBound(num), Inclusive: true})
+}
+
+// Max adds a validate whether input is less than or equal to num.
+// The error is *ErrBound.
+func (b *BigValidator[T]) Max(num T) *BigValidator[T] {
+	return b.compare(func(c int) bool { return c <= 0 }, num, &ErrBound{Err: ErrTooBig, Bound: bigBound(num), Inclusive: true})
+}
+
+// GreaterThan adds a validate whether input is greater than num.
+// The error is *ErrBound.
+func (b *BigValidator[T]) GreaterThan(num T) *BigValidator[T] {
+	return b.compare(func(c int) bool { return c > 0 }, num, &ErrBound{Err: ErrTooSmall, Bound: bigBound(num)})
+}
+
+// LessThan adds a validate whether input is less than num.
+// The error is *ErrBound.
+func (b *BigValidator[T]) LessThan(num T) *BigValidator[T] {
+	return b.compare(func(c int) bool { return c < 0 }, num, &ErrBound{Err: ErrTooBig, Bound: bigBound(num)})
+}
+
+func (b *BigValidator[T]) Equal(num T) *BigValidator[T] {
+	return b.compare(func(c int) bool { return c == 0 }, num, ErrNotEqual)
+}
+
+// Positive adds a validate whether input is greater than zero.
+func (b *BigValidator[T]) Positive() *BigValidator[T] {
+	return b.sign(func(s int) bool { return s > 0 }, &ErrBound{Err: ErrTooSmall, Bound: "0"})
+}
+
+// Negative adds a validate whether input is less than zero.
+func (b *BigValidator[T]) Negative() *BigValidator[T] {
+	return b.sign(func(s int) bool { return s < 0 }, &ErrBound{Err: ErrTooBig, Bound: "0"})
+}
+
+// NonZero adds a validate whether input is not zero.
+func (b *BigValidator[T]) NonZero() *BigValidator[T] {
+	return b.sign(func(s int) bool { return s != 0 }, ErrZero)
+}
+
+func (b *BigValidator[T]) Required() *BigValidator[T] {
+	return b.AppendValidate(func(value T) error {
+		if value == nil {
+			return ErrEmpty
+		}
+		return nil
+	})
+}
+
+func (b *BigValidator[T]) AppendValidate(funcs ...Validate[T]) *BigValidator[T] {
+	b.Validator = b.Validator.AppendValidate(funcs...)
+	return b
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (b *BigValidator[T]) AppendValidator(inner ValueValidator[T]) *BigValidator[T] {
+	b.Validator = b.Validator.AppendValidator(inner)
+	return b
+}
+
+func (b *BigValidator[T]) compare(ok func(int) bool, num T, err error) *BigValidator[T] {
+	bigBound(num)
+	return b.AppendValidate(func(value T) error {
+		if value != nil && !ok(value.Cmp(num)) {
+			return err
+		}
+		return nil
+	})
+}
+
+// bigBound returns num as the Bound of ErrBound, and panics if num is nil.
+func bigBound[T bigNumber[T]](num T) string {
+	if num == nil {
+		panic(fmt.Sprintf("bound of %T is nil", num))
+	}
+	return num.String()
+}
+
+func (b *BigValidator[T]) sign(ok func(int) bool, err error) *BigValidator[T] {
+	return b.AppendValidate(func(value T) error {
+		if value != nil && !ok(value.Sign()) {
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,96 @@
+package svalidator_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestBigInt_Validate(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.BigIntValidator
+			input     *big.Int
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"pass", args{svalidator.BigInt().Required().Min(big.NewInt(1)).Max(big.NewInt(10)), big.NewInt(10)}, nil},
+		{"too small", args{svalidator.BigInt().Min(big.NewInt(1)), big.NewInt(0)}, svalidator.ErrTooSmall},
+		{"too big", args{svalidator.BigInt().LessThan(big.NewInt(10)), big.NewInt(10)}, svalidator.ErrTooBig},
+		{"not greater than", args{svalidator.BigInt().GreaterThan(big.NewInt(10)), big.NewInt(10)}, svalidator.ErrTooSmall},
+		{"not equal", args{svalidator.BigInt().Equal(big.NewInt(10)), big.NewInt(9)}, svalidator.ErrNotEqual},
+		{"not positive", args{svalidator.BigInt().Positive(), big.NewInt(0)}, svalidator.ErrTooSmall},
+		{"not negative", args{svalidator.BigInt().Negative(), big.NewInt(0)}, svalidator.ErrTooBig},
+		{"zero", args{svalidator.BigInt().NonZero(), new(big.Int)}, svalidator.ErrZero},
+		{"skip nil", args{svalidator.BigInt().Min(big.NewInt(1)).Positive(), nil}, nil},
+		{"nil is required error", args{svalidator.BigInt().Required(), nil}, svalidator.ErrEmpty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestBigRatFloat_Validate(t *testing.T) {
+	assertError(t, nil, svalidator.BigRat().Max(big.NewRat(1, 2)).Validate(big.NewRat(1, 3)))
+	assertError(t, svalidator.ErrTooBig, svalidator.BigRat().Max(big.NewRat(1, 2)).Validate(big.NewRat(2, 3)))
+	assertError(t, nil, svalidator.BigFloat().Min(big.NewFloat(1.5)).Validate(big.NewFloat(1.5)))
+	assertError(t, svalidator.ErrTooSmall, svalidator.BigFloat().Min(big.NewFloat(1.5)).Validate(big.NewFloat(1.25)))
+}
+
+func TestBig_Object(t *testing.T) {
+	type Sample struct {
+		Amount *big.Int
+	}
+	v, err := svalidator.SafeObject(svalidator.ValidatorMap[Sample]{
+		"Amount": svalidator.BigInt().Max(big.NewInt(100)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = v.Validate(Sample{Amount: big.NewInt(101)})
+	var (
+		oerr svalidator.ErrObject
+		berr *svalidator.ErrBound
+	)
+	if !errors.As(err, &oerr) || !errors.As(oerr[0].Err, &berr) || berr.Bound != "100" || !berr.Inclusive {
+		t.Errorf("want bound error, but got: %v", err)
+	}
+
+	_, err = svalidator.SafeObject(svalidator.ValidatorMap[Sample]{
+		"Amount": svalidator.BigRat(),
+	})
+	assertIsError(t, true, err)
+}
+
+func TestBig_NilBound(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		bound func()
+	}{
+		{"int min", func() { svalidator.BigInt().Min(nil) }},
+		{"rat max", func() { svalidator.BigRat().Max(nil) }},
+		{"float greater than", func() { svalidator.BigFloat().GreaterThan(nil) }},
+		{"rat less than", func() { svalidator.BigRat().LessThan(nil) }},
+		{"int equal", func() { svalidator.BigInt().Equal(nil) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "is nil") {
+					t.Errorf("want panic of nil bound, but got: %v", r)
+				}
+			}()
+			tt.bound()
+		})
+	}
+}
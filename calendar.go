@@ -0,0 +1,100 @@
+package svalidator
+
+import "time"
+
+// HolidayCalendar decides whether a date is a holiday.
+type HolidayCalendar interface {
+	// IsHoliday reports whether the date of t in its location is a holiday.
+	IsHoliday(t time.Time) bool
+}
+
+// HolidayCalendarFunc is an adapter to use a function as HolidayCalendar.
+type HolidayCalendarFunc func(t time.Time) bool
+
+func (f HolidayCalendarFunc) IsHoliday(t time.Time) bool {
+	return f(t)
+}
+
+// Weekday adds a validate whether input is one of days.
+func (t *TimeValidator) Weekday(days ...time.Weekday) *TimeValidator {
+	return t.AppendValidate(t.inLocation(weekday(days)))
+}
+
+// TimeOfDayBetween adds a validate whether the time of day of input in loc is between from and to inclusive.
+// from and to are durations since midnight, and to may be less than from for a range over midnight.
+// If loc is nil, the location of input is used.
+func (t *TimeValidator) TimeOfDayBetween(from, to time.Duration, loc *time.Location) *TimeValidator {
+	return t.AppendValidate(timeOfDayBetween(from, to, loc))
+}
+
+// NotHoliday adds a validate whether input is not a holiday of calendar.
+func (t *TimeValidator) NotHoliday(calendar HolidayCalendar) *TimeValidator {
+	return t.AppendValidate(t.inLocation(notHoliday(calendar)))
+}
+
+// BusinessDay adds a validate whether input is a weekday from Monday to Friday and not a holiday of calendar.
+func (t *TimeValidator) BusinessDay(calendar HolidayCalendar) *TimeValidator {
+	return t.AppendValidate(t.inLocation(weekday(businessWeekdays)), t.inLocation(notHoliday(calendar)))
+}
+
+// Weekday adds a validate whether input is one of days.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) Weekday(days ...time.Weekday) *PointerTimeValidator {
+	return t.AppendValidate(skipNil(t.inLocation(weekday(days))))
+}
+
+// TimeOfDayBetween adds a validate whether the time of day of input in loc is between from and to inclusive.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) TimeOfDayBetween(from, to time.Duration, loc *time.Location) *PointerTimeValidator {
+	return t.AppendValidate(skipNil(timeOfDayBetween(from, to, loc)))
+}
+
+// NotHoliday adds a validate whether input is not a holiday of calendar.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) NotHoliday(calendar HolidayCalendar) *PointerTimeValidator {
+	return t.AppendValidate(skipNil(t.inLocation(notHoliday(calendar))))
+}
+
+// BusinessDay adds a validate whether input is a weekday from Monday to Friday and not a holiday of calendar.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) BusinessDay(calendar HolidayCalendar) *PointerTimeValidator {
+	return t.AppendValidate(skipNil(t.inLocation(weekday(businessWeekdays))), skipNil(t.inLocation(notHoliday(calendar))))
+}
+
+var businessWeekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+func weekday(days []time.Weekday) Validate[time.Time] {
+	return func(value time.Time) error {
+		for _, d := range days {
+			if value.Weekday() == d {
+				return nil
+			}
+		}
+		return ErrNotAllowedWeekday
+	}
+}
+
+func timeOfDayBetween(from, to time.Duration, loc *time.Location) Validate[time.Time] {
+	return func(value time.Time) error {
+		if loc != nil {
+			value = value.In(loc)
+		}
+		if !inTimeRange(from, to, TimeOfDayOf(value).SinceMidnight()) {
+			return ErrOutOfTimeRange
+		}
+		return nil
+	}
+}
+
+func inTimeRange(from, to, tod time.Duration) bool {
+	return from <= to && from <= tod && tod <= to || from > to && (from <= tod || tod <= to)
+}
+
+func notHoliday(calendar HolidayCalendar) Validate[time.Time] {
+	return func(value time.Time) error {
+		if calendar.IsHoliday(value) {
+			return ErrHoliday
+		}
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+package svalidator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestTime_Calendar(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	type (
+		args struct {
+			validator *svalidator.TimeValidator
+			input     time.Time
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"weekday", args{svalidator.Time().Weekday(time.Saturday, time.Sunday), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}, nil},
+		{"not weekday", args{svalidator.Time().Weekday(time.Saturday, time.Sunday), time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)}, svalidator.ErrNotAllowedWeekday},
+		{"time of day", args{svalidator.Time().TimeOfDayBetween(9*time.Hour, 18*time.Hour, tokyo), time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)}, nil},
+		{"time of day end", args{svalidator.Time().TimeOfDayBetween(9*time.Hour, 18*time.Hour, tokyo), time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)}, nil},
+		{"out of time of day", args{svalidator.Time().TimeOfDayBetween(9*time.Hour, 18*time.Hour, tokyo), time.Date(2024, 6, 3, 9, 0, 1, 0, time.UTC)}, svalidator.ErrOutOfTimeRange},
+		{"time of day in input location", args{svalidator.Time().TimeOfDayBetween(9*time.Hour, 18*time.Hour, nil), time.Date(2024, 6, 3, 9, 0, 1, 0, time.UTC)}, nil},
+		{"over midnight", args{svalidator.Time().TimeOfDayBetween(22*time.Hour, 2*time.Hour, nil), time.Date(2024, 6, 3, 1, 0, 0, 0, time.UTC)}, nil},
+		{"out of over midnight", args{svalidator.Time().TimeOfDayBetween(22*time.Hour, 2*time.Hour, nil), time.Date(2024, 6, 3, 3, 0, 0, 0, time.UTC)}, svalidator.ErrOutOfTimeRange},
+		{"not holiday", args{svalidator.Time().NotHoliday(svalidator.JapaneseHolidays), time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)}, nil},
+		{"holiday", args{svalidator.Time().NotHoliday(svalidator.JapaneseHolidays), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)}, svalidator.ErrHoliday},
+		{"business day", args{svalidator.Time().BusinessDay(svalidator.JapaneseHolidays), time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)}, nil},
+		{"business day on weekend", args{svalidator.Time().BusinessDay(svalidator.JapaneseHolidays), time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)}, svalidator.ErrNotAllowedWeekday},
+		{"business day on holiday", args{svalidator.Time().BusinessDay(svalidator.JapaneseHolidays), time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)}, svalidator.ErrHoliday},
+		{"custom calendar", args{svalidator.Time().NotHoliday(svalidator.HolidayCalendarFunc(func(t time.Time) bool {
+			return t.Month() == time.December && t.Day() == 31
+		})), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)}, svalidator.ErrHoliday},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestPointerTime_Calendar(t *testing.T) {
+	v := svalidator.PointerTime().Weekday(time.Monday).TimeOfDayBetween(0, time.Hour, nil).
+		NotHoliday(svalidator.JapaneseHolidays).BusinessDay(svalidator.JapaneseHolidays)
+	assertError(t, nil, v.Validate(nil))
+	assertError(t, nil, v.Validate(pointer(time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC))))
+	assertError(t, svalidator.ErrHoliday, v.Validate(pointer(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC))))
+}
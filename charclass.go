@@ -0,0 +1,161 @@
+package svalidator
+
+import (
+	"sort"
+	"unicode"
+)
+
+var (
+	// Hiragana is hiragana including the prolonged sound mark "ー".
+	Hiragana = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+			{Lo: 0x309d, Hi: 0x309f, Stride: 1},
+			{Lo: 0x30fc, Hi: 0x30fc, Stride: 1},
+		},
+	}
+	// FullWidthKatakana is full-width katakana including "ー" and "・".
+	FullWidthKatakana = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x30a1, Hi: 0x30fe, Stride: 1},
+		},
+	}
+	// HalfWidthKatakana is half-width katakana and its punctuations.
+	HalfWidthKatakana = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0xff61, Hi: 0xff9f, Stride: 1},
+		},
+	}
+	// JISLevel1Kanji is the first level kanji set of JIS X 0208.
+	JISLevel1Kanji = newRangeTable(jisLevel1Kanji)
+	// JISLevel2Kanji is the second level kanji set of JIS X 0208.
+	JISLevel2Kanji = newRangeTable(jisLevel2Kanji)
+
+	// jisSymbolHan is Han characters of JIS X 0208 row 1, such as the iteration mark "々".
+	jisSymbolHan = newRangeTable("仝々〆〇")
+)
+
+// OnlyRunes adds a validate whether every character of input is in one of tables.
+// The error is *ErrRune holding the first character which is not allowed.
+func (s *UStringValidator[T]) OnlyRunes(tables ...*unicode.RangeTable) *UStringValidator[T] {
+	return s.runes(func(r rune) bool { return !unicode.In(r, tables...) })
+}
+
+// ForbidRunes adds a validate whether input has no character in tables.
+// The error is *ErrRune holding the first character which is not allowed.
+func (s *UStringValidator[T]) ForbidRunes(tables ...*unicode.RangeTable) *UStringValidator[T] {
+	return s.runes(func(r rune) bool { return unicode.In(r, tables...) })
+}
+
+// Hiragana adds a validate whether input consists of hiragana.
+func (s *UStringValidator[T]) Hiragana() *UStringValidator[T] {
+	return s.OnlyRunes(Hiragana)
+}
+
+// FullWidthKatakana adds a validate whether input consists of full-width katakana.
+func (s *UStringValidator[T]) FullWidthKatakana() *UStringValidator[T] {
+	return s.OnlyRunes(FullWidthKatakana)
+}
+
+// NoHalfWidthKatakana adds a validate whether input has no half-width katakana.
+func (s *UStringValidator[T]) NoHalfWidthKatakana() *UStringValidator[T] {
+	return s.ForbidRunes(HalfWidthKatakana)
+}
+
+// JISKanji adds a validate whether every kanji of input is JIS X 0208 level 1 or 2 kanji,
+// or a kanji-like symbol of JIS X 0208 such as "々" and "〆".
+// Characters other than kanji are not checked.
+func (s *UStringValidator[T]) JISKanji() *UStringValidator[T] {
+	return s.runes(isNonJISKanji)
+}
+
+func (s *UStringValidator[T]) runes(invalid func(rune) bool) *UStringValidator[T] {
+	return s.AppendValidate(func(value T) error {
+		return findRune(string(value), invalid)
+	})
+}
+
+// OnlyRunes adds a validate whether every character of input is in one of tables.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) OnlyRunes(tables ...*unicode.RangeTable) *PointerUStringValidator[T] {
+	return s.runes(func(r rune) bool { return !unicode.In(r, tables...) })
+}
+
+// ForbidRunes adds a validate whether input has no character in tables.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) ForbidRunes(tables ...*unicode.RangeTable) *PointerUStringValidator[T] {
+	return s.runes(func(r rune) bool { return unicode.In(r, tables...) })
+}
+
+// Hiragana adds a validate whether input consists of hiragana.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) Hiragana() *PointerUStringValidator[T] {
+	return s.OnlyRunes(Hiragana)
+}
+
+// FullWidthKatakana adds a validate whether input consists of full-width katakana.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) FullWidthKatakana() *PointerUStringValidator[T] {
+	return s.OnlyRunes(FullWidthKatakana)
+}
+
+// NoHalfWidthKatakana adds a validate whether input has no half-width katakana.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) NoHalfWidthKatakana() *PointerUStringValidator[T] {
+	return s.ForbidRunes(HalfWidthKatakana)
+}
+
+// JISKanji adds a validate whether every kanji of input is JIS X 0208 level 1 or 2 kanji,
+// or a kanji-like symbol of JIS X 0208 such as "々" and "〆".
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) JISKanji() *PointerUStringValidator[T] {
+	return s.runes(isNonJISKanji)
+}
+
+func (s *PointerUStringValidator[T]) runes(invalid func(rune) bool) *PointerUStringValidator[T] {
+	return s.AppendValidate(func(value *T) error {
+		if value == nil {
+			return nil
+		}
+		return findRune(string(*value), invalid)
+	})
+}
+
+func findRune(s string, invalid func(rune) bool) error {
+	var pos int
+	for _, r := range s {
+		if invalid(r) {
+			return &ErrRune{Rune: r, Position: pos}
+		}
+		pos++
+	}
+	return nil
+}
+
+func isNonJISKanji(r rune) bool {
+	return unicode.Is(unicode.Han, r) && !unicode.In(r, JISLevel1Kanji, JISLevel2Kanji, jisSymbolHan)
+}
+
+// newRangeTable creates RangeTable which contains runes of chars.
+func newRangeTable(chars string) *unicode.RangeTable {
+	runes := []rune(chars)
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	table := new(unicode.RangeTable)
+	for _, r := range runes {
+		if r > 0xffff {
+			if n := len(table.R32); n > 0 && table.R32[n-1].Hi+1 == uint32(r) {
+				table.R32[n-1].Hi++
+				continue
+			}
+			table.R32 = append(table.R32, unicode.Range32{Lo: uint32(r), Hi: uint32(r), Stride: 1})
+			continue
+		}
+		if n := len(table.R16); n > 0 && table.R16[n-1].Hi+1 == uint16(r) {
+			table.R16[n-1].Hi++
+			continue
+		}
+		table.R16 = append(table.R16, unicode.Range16{Lo: uint16(r), Hi: uint16(r), Stride: 1})
+	}
+	return table
+}
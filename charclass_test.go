@@ -0,0 +1,73 @@
+package svalidator_test
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestUString_Runes(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.StringValidator
+			input     string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"hiragana", args{svalidator.String().Hiragana(), "やまだたろー"}, nil},
+		{"hiragana error", args{svalidator.String().Hiragana(), "やまだタロウ"}, &svalidator.ErrRune{Rune: 'タ', Position: 3}},
+		{"full width katakana", args{svalidator.String().FullWidthKatakana(), "ヤマダ・タロー"}, nil},
+		{"full width katakana error", args{svalidator.String().FullWidthKatakana(), "ヤマダ ﾀﾛｳ"}, &svalidator.ErrRune{Rune: ' ', Position: 3}},
+		{"no half width katakana", args{svalidator.String().NoHalfWidthKatakana(), "山田太郎"}, nil},
+		{"no half width katakana error", args{svalidator.String().NoHalfWidthKatakana(), "山田ﾀﾛｳ"}, &svalidator.ErrRune{Rune: 'ﾀ', Position: 2}},
+		{"jis kanji", args{svalidator.String().JISKanji(), "亜細亜と凜"}, nil},
+		{"jis kanji iteration mark", args{svalidator.String().JISKanji(), "佐々木"}, nil},
+		{"jis kanji symbols", args{svalidator.String().JISKanji(), "〆切〇仝"}, nil},
+		{"jis kanji radical error", args{svalidator.String().JISKanji(), "佐⺅"}, &svalidator.ErrRune{Rune: '⺅', Position: 1}},
+		{"jis kanji error", args{svalidator.String().JISKanji(), "髙橋"}, &svalidator.ErrRune{Rune: '髙', Position: 0}},
+		{"only runes", args{svalidator.String().OnlyRunes(unicode.Digit, unicode.Hyphen), "03-1234"}, nil},
+		{"forbid runes", args{svalidator.String().ForbidRunes(unicode.Digit), "abc1"}, &svalidator.ErrRune{Rune: '1', Position: 3}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			if tt.err == nil {
+				assertError(t, nil, err)
+				return
+			}
+			assertError(t, svalidator.ErrInvalidRune, err)
+			var rerr *svalidator.ErrRune
+			if !errors.As(err, &rerr) || *rerr != *tt.err.(*svalidator.ErrRune) {
+				t.Errorf("want: %v.\nbut got: %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestPointerUString_Runes(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.PointerStringValidator
+			input     *string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"nil", args{svalidator.PointerString().Hiragana().FullWidthKatakana().JISKanji(), nil}, nil},
+		{"hiragana error", args{svalidator.PointerString().Hiragana(), pointer("a")}, svalidator.ErrInvalidRune},
+		{"no half width katakana error", args{svalidator.PointerString().NoHalfWidthKatakana(), pointer("ｱ")}, svalidator.ErrMismatchPattern},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
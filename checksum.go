@@ -0,0 +1,343 @@
+package svalidator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CardBrand is a brand of credit card.
+type CardBrand int
+
+const (
+	CardVisa CardBrand = iota + 1
+	CardMastercard
+	CardAmex
+	CardJCB
+	CardDiners
+	CardDiscover
+	CardUnionPay
+)
+
+// cardBrands defines the prefix ranges and the lengths of each brand.
+var cardBrands = map[CardBrand]struct {
+	prefixes [][2]int
+	lengths  [2]int
+}{
+	CardVisa:       {[][2]int{{4, 4}}, [2]int{13, 19}},
+	CardMastercard: {[][2]int{{51, 55}, {2221, 2720}}, [2]int{16, 16}},
+	CardAmex:       {[][2]int{{34, 34}, {37, 37}}, [2]int{15, 15}},
+	CardJCB:        {[][2]int{{3528, 3589}}, [2]int{16, 19}},
+	CardDiners:     {[][2]int{{300, 305}, {36, 36}, {38, 39}}, [2]int{14, 19}},
+	CardDiscover:   {[][2]int{{6011, 6011}, {644, 649}, {65, 65}}, [2]int{16, 19}},
+	CardUnionPay:   {[][2]int{{62, 62}}, [2]int{16, 19}},
+}
+
+// ibanLengths is the length of IBAN for each country.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20,
+	"LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+	"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28,
+	"PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31,
+	"SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// Luhn adds a validate whether input is digits with a valid Luhn check digit.
+func (s *UStringValidator[T]) Luhn() *UStringValidator[T] {
+	return s.check(checkLuhn)
+}
+
+// CreditCard adds a validate whether input is a credit card number of brands.
+// Spaces and hyphens between digits are ignored.
+// If brands is not specified, any brand is accepted.
+func (s *UStringValidator[T]) CreditCard(brands ...CardBrand) *UStringValidator[T] {
+	return s.check(func(str string) error { return checkCreditCard(str, brands) })
+}
+
+// ISBN adds a validate whether input is ISBN-10 or ISBN-13. Hyphens are ignored.
+func (s *UStringValidator[T]) ISBN() *UStringValidator[T] {
+	return s.check(checkISBN)
+}
+
+// ISBN10 adds a validate whether input is ISBN-10. Hyphens are ignored.
+func (s *UStringValidator[T]) ISBN10() *UStringValidator[T] {
+	return s.check(checkISBN10)
+}
+
+// ISBN13 adds a validate whether input is ISBN-13. Hyphens are ignored.
+func (s *UStringValidator[T]) ISBN13() *UStringValidator[T] {
+	return s.check(checkISBN13)
+}
+
+// EAN8 adds a validate whether input is EAN-8.
+func (s *UStringValidator[T]) EAN8() *UStringValidator[T] {
+	return s.check(func(str string) error { return checkEAN(str, 8) })
+}
+
+// EAN13 adds a validate whether input is EAN-13.
+func (s *UStringValidator[T]) EAN13() *UStringValidator[T] {
+	return s.check(func(str string) error { return checkEAN(str, 13) })
+}
+
+// JAN adds a validate whether input is a JAN code, which is EAN-13 or EAN-8 beginning with 45 or 49.
+func (s *UStringValidator[T]) JAN() *UStringValidator[T] {
+	return s.check(checkJAN)
+}
+
+// IBAN adds a validate whether input is IBAN. Spaces are ignored.
+func (s *UStringValidator[T]) IBAN() *UStringValidator[T] {
+	return s.check(checkIBAN)
+}
+
+// Luhn adds a validate whether input is digits with a valid Luhn check digit.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) Luhn() *PointerUStringValidator[T] {
+	return s.check(checkLuhn)
+}
+
+// CreditCard adds a validate whether input is a credit card number of brands.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) CreditCard(brands ...CardBrand) *PointerUStringValidator[T] {
+	return s.check(func(str string) error { return checkCreditCard(str, brands) })
+}
+
+// ISBN adds a validate whether input is ISBN-10 or ISBN-13.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) ISBN() *PointerUStringValidator[T] {
+	return s.check(checkISBN)
+}
+
+// ISBN10 adds a validate whether input is ISBN-10.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) ISBN10() *PointerUStringValidator[T] {
+	return s.check(checkISBN10)
+}
+
+// ISBN13 adds a validate whether input is ISBN-13.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) ISBN13() *PointerUStringValidator[T] {
+	return s.check(checkISBN13)
+}
+
+// EAN8 adds a validate whether input is EAN-8.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) EAN8() *PointerUStringValidator[T] {
+	return s.check(func(str string) error { return checkEAN(str, 8) })
+}
+
+// EAN13 adds a validate whether input is EAN-13.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) EAN13() *PointerUStringValidator[T] {
+	return s.check(func(str string) error { return checkEAN(str, 13) })
+}
+
+// JAN adds a validate whether input is a JAN code.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) JAN() *PointerUStringValidator[T] {
+	return s.check(checkJAN)
+}
+
+// IBAN adds a validate whether input is IBAN.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) IBAN() *PointerUStringValidator[T] {
+	return s.check(checkIBAN)
+}
+
+func checkLuhn(s string) error {
+	if len(s) < 2 || !isDigits(s) {
+		return &ErrIdentifier{Kind: ErrInvalidLuhn, Reason: ErrInvalidStructure}
+	}
+	if !luhn(s) {
+		return &ErrIdentifier{Kind: ErrInvalidLuhn, Reason: ErrInvalidChecksum}
+	}
+	return nil
+}
+
+func luhn(digits string) bool {
+	var sum int
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func checkCreditCard(s string, brands []CardBrand) error {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+	if !isDigits(digits) {
+		return &ErrIdentifier{Kind: ErrInvalidCreditCard, Reason: ErrInvalidStructure}
+	}
+	if len(brands) == 0 {
+		brands = []CardBrand{CardVisa, CardMastercard, CardAmex, CardJCB, CardDiners, CardDiscover, CardUnionPay}
+	}
+
+	reason := ErrInvalidStructure
+	for _, brand := range brands {
+		b := cardBrands[brand]
+		if !hasNumberPrefix(digits, b.prefixes) {
+			continue
+		}
+		if len(digits) < b.lengths[0] || b.lengths[1] < len(digits) {
+			reason = ErrInvalidLength
+			continue
+		}
+		if !luhn(digits) {
+			return &ErrIdentifier{Kind: ErrInvalidCreditCard, Reason: ErrInvalidChecksum}
+		}
+		return nil
+	}
+	return &ErrIdentifier{Kind: ErrInvalidCreditCard, Reason: reason}
+}
+
+func hasNumberPrefix(digits string, ranges [][2]int) bool {
+	for _, r := range ranges {
+		n := len(strconv.Itoa(r[0]))
+		if len(digits) < n {
+			continue
+		}
+		prefix, _ := strconv.Atoi(digits[:n])
+		if r[0] <= prefix && prefix <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func checkISBN(s string) error {
+	if len(strings.ReplaceAll(s, "-", "")) == 10 {
+		return checkISBN10(s)
+	}
+	return checkISBN13(s)
+}
+
+func checkISBN10(s string) error {
+	digits := strings.ReplaceAll(s, "-", "")
+	if len(digits) != 10 {
+		return &ErrIdentifier{Kind: ErrInvalidISBN, Reason: ErrInvalidLength}
+	}
+	var sum int
+	for i := 0; i < 10; i++ {
+		c := digits[i]
+		var d int
+		switch {
+		case '0' <= c && c <= '9':
+			d = int(c - '0')
+		case i == 9 && (c == 'X' || c == 'x'):
+			d = 10
+		default:
+			return &ErrIdentifier{Kind: ErrInvalidISBN, Reason: ErrInvalidStructure}
+		}
+		sum += (10 - i) * d
+	}
+	if sum%11 != 0 {
+		return &ErrIdentifier{Kind: ErrInvalidISBN, Reason: ErrInvalidChecksum}
+	}
+	return nil
+}
+
+func checkISBN13(s string) error {
+	digits := strings.ReplaceAll(s, "-", "")
+	switch {
+	case !isDigits(digits):
+		return &ErrIdentifier{Kind: ErrInvalidISBN, Reason: ErrInvalidStructure}
+	case len(digits) != 13:
+		return &ErrIdentifier{Kind: ErrInvalidISBN, Reason: ErrInvalidLength}
+	case !strings.HasPrefix(digits, "978") && !strings.HasPrefix(digits, "979"):
+		return &ErrIdentifier{Kind: ErrInvalidISBN, Reason: ErrInvalidStructure}
+	case !gtin(digits):
+		return &ErrIdentifier{Kind: ErrInvalidISBN, Reason: ErrInvalidChecksum}
+	}
+	return nil
+}
+
+func checkEAN(s string, length int) error {
+	switch {
+	case !isDigits(s):
+		return &ErrIdentifier{Kind: ErrInvalidEAN, Reason: ErrInvalidStructure}
+	case len(s) != length:
+		return &ErrIdentifier{Kind: ErrInvalidEAN, Reason: ErrInvalidLength}
+	case !gtin(s):
+		return &ErrIdentifier{Kind: ErrInvalidEAN, Reason: ErrInvalidChecksum}
+	}
+	return nil
+}
+
+func checkJAN(s string) error {
+	length := 13
+	if len(s) == 8 {
+		length = 8
+	}
+	if err := checkEAN(s, length); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(s, "45") && !strings.HasPrefix(s, "49") {
+		return &ErrIdentifier{Kind: ErrInvalidEAN, Reason: ErrInvalidStructure}
+	}
+	return nil
+}
+
+// gtin reports whether the last digit of digits is a valid GTIN check digit.
+func gtin(digits string) bool {
+	var sum int
+	for i := 0; i < len(digits)-1; i++ {
+		d := int(digits[len(digits)-2-i] - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return int(digits[len(digits)-1]-'0') == (10-sum%10)%10
+}
+
+func checkIBAN(s string) error {
+	iban := strings.ReplaceAll(s, " ", "")
+	if len(iban) < 4 || !isUpperAlpha(iban[:2]) || !isDigits(iban[2:4]) {
+		return &ErrIdentifier{Kind: ErrInvalidIBAN, Reason: ErrInvalidStructure}
+	}
+	for i := 4; i < len(iban); i++ {
+		if c := iban[i]; !('0' <= c && c <= '9' || 'A' <= c && c <= 'Z') {
+			return &ErrIdentifier{Kind: ErrInvalidIBAN, Reason: ErrInvalidStructure}
+		}
+	}
+	length, ok := ibanLengths[iban[:2]]
+	if !ok {
+		return &ErrIdentifier{Kind: ErrInvalidIBAN, Reason: ErrInvalidStructure}
+	}
+	if len(iban) != length {
+		return &ErrIdentifier{Kind: ErrInvalidIBAN, Reason: ErrInvalidLength}
+	}
+
+	// move the first 4 characters to the end, and replace letters with 10-35.
+	var mod int
+	for _, c := range iban[4:] + iban[:4] {
+		if c >= 'A' {
+			mod = (mod*100 + int(c-'A') + 10) % 97
+			continue
+		}
+		mod = (mod*10 + int(c-'0')) % 97
+	}
+	if mod != 1 {
+		return &ErrIdentifier{Kind: ErrInvalidIBAN, Reason: ErrInvalidChecksum}
+	}
+	return nil
+}
+
+func isUpperAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || 'Z' < s[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package svalidator_test
+
+import (
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestUString_Checksum(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.StringValidator
+			input     string
+		}
+	)
+	for _, tt := range []struct {
+		name   string
+		args   args
+		kind   error
+		reason error
+	}{
+		{"luhn", args{svalidator.String().Luhn(), "79927398713"}, nil, nil},
+		{"luhn checksum", args{svalidator.String().Luhn(), "79927398710"}, svalidator.ErrInvalidLuhn, svalidator.ErrInvalidChecksum},
+		{"luhn structure", args{svalidator.String().Luhn(), "7992a"}, svalidator.ErrInvalidLuhn, svalidator.ErrInvalidStructure},
+		{"visa", args{svalidator.String().CreditCard(), "4111 1111 1111 1111"}, nil, nil},
+		{"visa checksum", args{svalidator.String().CreditCard(), "4111111111111112"}, svalidator.ErrInvalidCreditCard, svalidator.ErrInvalidChecksum},
+		{"amex", args{svalidator.String().CreditCard(svalidator.CardAmex), "378282246310005"}, nil, nil},
+		{"amex length", args{svalidator.String().CreditCard(svalidator.CardAmex), "3782822463100055"}, svalidator.ErrInvalidCreditCard, svalidator.ErrInvalidLength},
+		{"mastercard 2 series", args{svalidator.String().CreditCard(svalidator.CardMastercard), "2223003122003222"}, nil, nil},
+		{"jcb", args{svalidator.String().CreditCard(svalidator.CardJCB), "3530-1113-3330-0000"}, nil, nil},
+		{"brand mismatch", args{svalidator.String().CreditCard(svalidator.CardJCB), "4111111111111111"}, svalidator.ErrInvalidCreditCard, svalidator.ErrInvalidStructure},
+		{"isbn10", args{svalidator.String().ISBN10(), "0-306-40615-2"}, nil, nil},
+		{"isbn10 with x", args{svalidator.String().ISBN(), "0-8044-2957-X"}, nil, nil},
+		{"isbn10 checksum", args{svalidator.String().ISBN10(), "0-306-40615-3"}, svalidator.ErrInvalidISBN, svalidator.ErrInvalidChecksum},
+		{"isbn13", args{svalidator.String().ISBN(), "978-0-306-40615-7"}, nil, nil},
+		{"isbn13 prefix", args{svalidator.String().ISBN13(), "4006381333931"}, svalidator.ErrInvalidISBN, svalidator.ErrInvalidStructure},
+		{"isbn length", args{svalidator.String().ISBN(), "978-0-306-40615"}, svalidator.ErrInvalidISBN, svalidator.ErrInvalidLength},
+		{"ean13", args{svalidator.String().EAN13(), "4006381333931"}, nil, nil},
+		{"ean13 checksum", args{svalidator.String().EAN13(), "4006381333932"}, svalidator.ErrInvalidEAN, svalidator.ErrInvalidChecksum},
+		{"ean8", args{svalidator.String().EAN8(), "73513537"}, nil, nil},
+		{"ean8 length", args{svalidator.String().EAN8(), "4006381333931"}, svalidator.ErrInvalidEAN, svalidator.ErrInvalidLength},
+		{"jan", args{svalidator.String().JAN(), "4901234567894"}, nil, nil},
+		{"jan prefix", args{svalidator.String().JAN(), "4006381333931"}, svalidator.ErrInvalidEAN, svalidator.ErrInvalidStructure},
+		{"iban", args{svalidator.String().IBAN(), "GB82 WEST 1234 5698 7654 32"}, nil, nil},
+		{"iban de", args{svalidator.String().IBAN(), "DE89370400440532013000"}, nil, nil},
+		{"iban checksum", args{svalidator.String().IBAN(), "GB82WEST12345698765433"}, svalidator.ErrInvalidIBAN, svalidator.ErrInvalidChecksum},
+		{"iban length", args{svalidator.String().IBAN(), "GB82WEST123456987654"}, svalidator.ErrInvalidIBAN, svalidator.ErrInvalidLength},
+		{"iban country", args{svalidator.String().IBAN(), "ZZ82WEST12345698765432"}, svalidator.ErrInvalidIBAN, svalidator.ErrInvalidStructure},
+		{"iban lower case", args{svalidator.String().IBAN(), "gb82west12345698765432"}, svalidator.ErrInvalidIBAN, svalidator.ErrInvalidStructure},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			if tt.kind == nil {
+				assertError(t, nil, err)
+				return
+			}
+			assertError(t, tt.kind, err)
+			assertError(t, tt.reason, err)
+		})
+	}
+}
+
+func TestPointerUString_Checksum(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.PointerStringValidator
+			input     *string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"nil", args{svalidator.PointerString().Luhn().CreditCard().ISBN().EAN13().JAN().IBAN(), nil}, nil},
+		{"credit card", args{svalidator.PointerString().CreditCard(), pointer("4111111111111111")}, nil},
+		{"iban error", args{svalidator.PointerString().IBAN(), pointer("GB82")}, svalidator.ErrInvalidIBAN},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package svalidator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time to relative time rules such as NotInFuture.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is an adapter to use a function as Clock.
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is Clock which returns time.Now.
+var SystemClock Clock = ClockFunc(time.Now)
+
+type clockKey struct{}
+
+// WithClock returns ctx which makes relative time rules use clock.
+// This takes precedence over the clock set to the validator.
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// now returns the current time from ctx, clock or SystemClock in order.
+func now(ctx context.Context, clock Clock) time.Time {
+	if c, ok := ctx.Value(clockKey{}).(Clock); ok {
+		return c.Now()
+	}
+	if clock != nil {
+		return clock.Now()
+	}
+	return SystemClock.Now()
+}
+
+// FakeClock is Clock for tests which returns the time set by Set or Advance.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns FakeClock which returns now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set sets the current time.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Advance moves the current time forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Clock sets clock used by relative time rules.
+func (t *TimeValidator) Clock(clock Clock) *TimeValidator {
+	t.clock = clock
+	return t
+}
+
+// NotInFuture adds a validate whether input is not after the current time.
+func (t *TimeValidator) NotInFuture() *TimeValidator {
+	return t.relative(notInFuture)
+}
+
+// NotInPast adds a validate whether input is not before the current time.
+func (t *TimeValidator) NotInPast() *TimeValidator {
+	return t.relative(notInPast)
+}
+
+// WithinLast adds a validate whether input is between d before the current time and the current time.
+func (t *TimeValidator) WithinLast(d time.Duration) *TimeValidator {
+	return t.relative(func(value, now time.Time) error { return within(value, now.Add(-d), now) })
+}
+
+// WithinNext adds a validate whether input is between the current time and d after the current time.
+func (t *TimeValidator) WithinNext(d time.Duration) *TimeValidator {
+	return t.relative(func(value, now time.Time) error { return within(value, now, now.Add(d)) })
+}
+
+// AgeAtLeast adds a validate whether input as a birthday is at least years old on the current date.
+func (t *TimeValidator) AgeAtLeast(years int) *TimeValidator {
+	return t.relative(func(value, now time.Time) error { return ageAtLeast(t.in(value), t.in(now), years) })
+}
+
+func (t *TimeValidator) relative(f func(value, now time.Time) error) *TimeValidator {
+	t.Validator = t.Validator.AppendContextValidate(func(ctx context.Context, value time.Time) error {
+		return f(value, now(ctx, t.clock))
+	})
+	return t
+}
+
+// Clock sets clock used by relative time rules.
+func (t *PointerTimeValidator) Clock(clock Clock) *PointerTimeValidator {
+	t.clock = clock
+	return t
+}
+
+// NotInFuture adds a validate whether input is not after the current time.
+func (t *PointerTimeValidator) NotInFuture() *PointerTimeValidator {
+	return t.relative(notInFuture)
+}
+
+// NotInPast adds a validate whether input is not before the current time.
+func (t *PointerTimeValidator) NotInPast() *PointerTimeValidator {
+	return t.relative(notInPast)
+}
+
+// WithinLast adds a validate whether input is between d before the current time and the current time.
+func (t *PointerTimeValidator) WithinLast(d time.Duration) *PointerTimeValidator {
+	return t.relative(func(value, now time.Time) error { return within(value, now.Add(-d), now) })
+}
+
+// WithinNext adds a validate whether input is between the current time and d after the current time.
+func (t *PointerTimeValidator) WithinNext(d time.Duration) *PointerTimeValidator {
+	return t.relative(func(value, now time.Time) error { return within(value, now, now.Add(d)) })
+}
+
+// AgeAtLeast adds a validate whether input as a birthday is at least years old on the current date.
+func (t *PointerTimeValidator) AgeAtLeast(years int) *PointerTimeValidator {
+	return t.relative(func(value, now time.Time) error { return ageAtLeast(t.in(value), t.in(now), years) })
+}
+
+func (t *PointerTimeValidator) relative(f func(value, now time.Time) error) *PointerTimeValidator {
+	t.Validator = t.Validator.AppendContextValidate(func(ctx context.Context, value *time.Time) error {
+		if value == nil {
+			return nil
+		}
+		return f(*value, now(ctx, t.clock))
+	})
+	return t
+}
+
+func notInFuture(value, now time.Time) error {
+	if value.After(now) {
+		return ErrTooBig
+	}
+	return nil
+}
+
+func notInPast(value, now time.Time) error {
+	if value.Before(now) {
+		return ErrTooSmall
+	}
+	return nil
+}
+
+func within(value, from, to time.Time) error {
+	if value.Before(from) {
+		return ErrTooSmall
+	}
+	if value.After(to) {
+		return ErrTooBig
+	}
+	return nil
+}
+
+func ageAtLeast(birthday, now time.Time, years int) error {
+	now = now.In(birthday.Location())
+	// compare dates only, so that the age increases at 0:00 of the birthday.
+	adult := time.Date(birthday.Year()+years, birthday.Month(), birthday.Day(), 0, 0, 0, 0, birthday.Location())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, birthday.Location())
+	if today.Before(adult) {
+		return ErrTooSmall
+	}
+	return nil
+}
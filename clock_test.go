@@ -0,0 +1,68 @@
+package svalidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestTime_Relative(t *testing.T) {
+	now := time.Date(2021, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := svalidator.NewFakeClock(now)
+	type (
+		args struct {
+			validator *svalidator.TimeValidator
+			input     time.Time
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"not in future", args{svalidator.Time().Clock(clock).NotInFuture(), now}, nil},
+		{"in future", args{svalidator.Time().Clock(clock).NotInFuture(), now.Add(time.Second)}, svalidator.ErrTooBig},
+		{"not in past", args{svalidator.Time().Clock(clock).NotInPast(), now}, nil},
+		{"in past", args{svalidator.Time().Clock(clock).NotInPast(), now.Add(-time.Second)}, svalidator.ErrTooSmall},
+		{"within last", args{svalidator.Time().Clock(clock).WithinLast(time.Hour), now.Add(-time.Hour)}, nil},
+		{"before last", args{svalidator.Time().Clock(clock).WithinLast(time.Hour), now.Add(-time.Hour - 1)}, svalidator.ErrTooSmall},
+		{"after last", args{svalidator.Time().Clock(clock).WithinLast(time.Hour), now.Add(1)}, svalidator.ErrTooBig},
+		{"within next", args{svalidator.Time().Clock(clock).WithinNext(time.Hour), now.Add(time.Hour)}, nil},
+		{"after next", args{svalidator.Time().Clock(clock).WithinNext(time.Hour), now.Add(time.Hour + 1)}, svalidator.ErrTooBig},
+		{"age", args{svalidator.Time().Clock(clock).AgeAtLeast(18), time.Date(2003, 6, 15, 0, 0, 0, 0, time.UTC)}, nil},
+		{"too young", args{svalidator.Time().Clock(clock).AgeAtLeast(18), time.Date(2003, 6, 16, 0, 0, 0, 0, time.UTC)}, svalidator.ErrTooSmall},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestPointerTime_Relative(t *testing.T) {
+	now := time.Date(2021, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := svalidator.NewFakeClock(now)
+
+	v := svalidator.PointerTime().Clock(clock).NotInFuture().NotInPast().WithinLast(0).WithinNext(0).AgeAtLeast(0)
+	assertError(t, nil, v.Validate(nil))
+	assertError(t, nil, v.Validate(pointer(now)))
+	assertError(t, svalidator.ErrTooBig, v.Validate(pointer(now.Add(time.Second))))
+}
+
+func TestTime_ContextClock(t *testing.T) {
+	now := time.Date(2021, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := svalidator.NewFakeClock(now)
+	v := svalidator.Time().Clock(clock).NotInFuture()
+
+	input := now.Add(time.Hour)
+	assertError(t, svalidator.ErrTooBig, v.Validate(input))
+
+	clock.Advance(time.Hour)
+	assertError(t, nil, v.Validate(input))
+
+	ctx := svalidator.WithClock(context.Background(), svalidator.NewFakeClock(now))
+	assertError(t, svalidator.ErrTooBig, v.ValidateContext(ctx, input))
+}
@@ -0,0 +1,434 @@
+package svalidator
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	timeType            = reflect.TypeOf(time.Time{})
+)
+
+// Coercion is a policy for converting JSON values to the input type of validators in MapValidator.
+type Coercion uint8
+
+const (
+	// NoCoercion requires the type of value to be the input type of validator. This is the default.
+	NoCoercion Coercion = iota
+	// CoerceStrict converts float64, json.Number and other numbers to a numeric type
+	// rejecting overflow with ErrNumberOverflow and fractional part with ErrFractional,
+	// and RFC 3339 strings to time.Time. Pointers to them are converted as well.
+	CoerceStrict
+	// CoerceLenient is same as CoerceStrict, but truncates fractional part toward zero for integer types.
+	CoerceLenient
+)
+
+// coerceValue converts raw to typ by coercion.
+// If raw can not be converted, it returns an error wrapping ErrInvalidType.
+func coerceValue(raw any, typ reflect.Type, coercion Coercion) (any, error) {
+	if reflect.TypeOf(raw) == typ {
+		return raw, nil
+	}
+	if coercion != NoCoercion {
+		if v, ok, err := coerceJSON(raw, typ, coercion); ok {
+			return v, err
+		}
+	}
+	return nil, invalidType(raw, typ)
+}
+
+// coerceJSON converts raw to typ. ok is false when the conversion is not supported.
+func coerceJSON(raw any, typ reflect.Type, coercion Coercion) (v any, ok bool, err error) {
+	target := typ
+	if typ.Kind() == reflect.Pointer {
+		target = typ.Elem()
+	}
+	var rv reflect.Value
+	switch {
+	case target == timeType:
+		s, isString := raw.(string)
+		if !isString {
+			return nil, false, nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, true, ErrInvalidTime
+		}
+		rv = reflect.ValueOf(t)
+	case isNumberKind(target.Kind()):
+		_, f, err := parseNumber(raw)
+		if errors.Is(err, ErrInvalidType) {
+			return nil, false, nil
+		}
+		rv, err = convertNumber(raw, target)
+		if errors.Is(err, ErrFractional) && coercion == CoerceLenient {
+			rv, err = convertNumber(math.Trunc(f), target)
+		}
+		if err != nil {
+			return nil, true, err
+		}
+	default:
+		return nil, false, nil
+	}
+	if typ.Kind() == reflect.Pointer {
+		p := reflect.New(target)
+		p.Elem().Set(rv)
+		rv = p
+	}
+	return rv.Interface(), true, nil
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// convertInto sets raw, a value decoded from JSON, to dst converting it to the type of dst.
+// Errors of struct fields, slice elements and map values are returned as ErrObject.
+func convertInto(dst reflect.Value, raw any) error {
+	typ := dst.Type()
+	if raw == nil {
+		dst.Set(reflect.Zero(typ))
+		return nil
+	}
+	if rv := reflect.ValueOf(raw); rv.Type().AssignableTo(typ) {
+		dst.Set(rv)
+		return nil
+	}
+	if s, ok := raw.(string); ok && reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		if err := dst.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidType, err)
+		}
+		return nil
+	}
+
+	if isNumberKind(typ.Kind()) {
+		v, err := convertNumber(raw, typ)
+		if err != nil {
+			return err
+		}
+		dst.Set(v)
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		if s, ok := raw.(string); ok {
+			dst.SetString(s)
+			return nil
+		}
+	case reflect.Bool:
+		if b, ok := raw.(bool); ok {
+			dst.SetBool(b)
+			return nil
+		}
+	case reflect.Pointer:
+		elem := reflect.New(typ.Elem())
+		if err := convertInto(elem.Elem(), raw); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case reflect.Struct:
+		if m, ok := raw.(map[string]any); ok {
+			return newErrObject(decodeStruct(dst, m)...)
+		}
+	case reflect.Slice:
+		if arr, ok := raw.([]any); ok {
+			s := reflect.MakeSlice(typ, len(arr), len(arr))
+			var merr []*ErrObjectField
+			for i, elem := range arr {
+				if err := convertInto(s.Index(i), elem); err != nil {
+					merr = append(merr, newErrObjectField(strconv.Itoa(i), err))
+				}
+			}
+			dst.Set(s)
+			return newErrObject(merr...)
+		}
+	case reflect.Map:
+		if m, ok := raw.(map[string]any); ok && typ.Key().Kind() == reflect.String {
+			keys := make([]string, 0, len(m))
+			for k := range m {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			mv := reflect.MakeMapWithSize(typ, len(m))
+			var merr []*ErrObjectField
+			for _, k := range keys {
+				elem := reflect.New(typ.Elem()).Elem()
+				if err := convertInto(elem, m[k]); err != nil {
+					merr = append(merr, newErrObjectField(k, err))
+					continue
+				}
+				mv.SetMapIndex(reflect.ValueOf(k).Convert(typ.Key()), elem)
+			}
+			dst.Set(mv)
+			return newErrObject(merr...)
+		}
+	}
+	return invalidType(raw, typ)
+}
+
+// decodeStruct sets the values of m to the fields of dst.
+// Keys are matched to the fields as encoding/json: by the name of json tag or the field name,
+// case-insensitively, and the fields of embedded structs are promoted.
+// Errors of promoted fields are reported under the embedded field.
+func decodeStruct(dst reflect.Value, m map[string]any) []*ErrObjectField {
+	var merr ErrObject
+	for _, field := range jsonFields(dst.Type()) {
+		raw, exists := lookupKey(m, field.name)
+		if !exists {
+			continue
+		}
+		fv, ok := fieldByIndex(dst, field.index)
+		if !ok {
+			continue
+		}
+		if err := convertInto(fv, raw); err != nil {
+			for i := len(field.path) - 1; i > 0; i-- {
+				err = ErrObject{newErrObjectField(field.path[i], err)}
+			}
+			merr = merr.add(newErrObjectField(field.path[0], err))
+		}
+	}
+	return merr
+}
+
+// jsonField is a field of struct decoded from a JSON object.
+type jsonField struct {
+	// name is the key of JSON.
+	name string
+	// index is the index sequence for reflect.Value.FieldByIndex.
+	index []int
+	// path is the names of fields from the struct, which has the embedded fields of a promoted field.
+	path   []string
+	tagged bool
+}
+
+// jsonFields returns the fields of rt in the way of encoding/json.
+// Fields of embedded structs without json name are promoted, and a field at shallower depth
+// or with json tag hides other fields of the same name. Conflicting fields are ignored.
+func jsonFields(rt reflect.Type) []jsonField {
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+		path  []string
+	}
+	var (
+		fields  []jsonField
+		hidden  = map[string]bool{}
+		visited = map[reflect.Type]bool{}
+		current = []embedded{{typ: rt}}
+	)
+	for len(current) > 0 {
+		var (
+			next  []embedded
+			level = map[string][]jsonField{}
+			names []string
+		)
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+			visited[e.typ] = true
+			for i := 0; i < e.typ.NumField(); i++ {
+				sf := e.typ.Field(i)
+				tag, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+				if tag == "-" {
+					continue
+				}
+				index := append(e.index[:len(e.index):len(e.index)], i)
+				path := append(e.path[:len(e.path):len(e.path)], sf.Name)
+				if sf.Anonymous {
+					ft := sf.Type
+					if ft.Kind() == reflect.Pointer {
+						ft = ft.Elem()
+					}
+					// an unexported embedded field is used only for promoted fields of a non-pointer struct.
+					if !sf.IsExported() && (sf.Type.Kind() == reflect.Pointer || ft.Kind() != reflect.Struct) {
+						continue
+					}
+					if tag == "" && ft.Kind() == reflect.Struct {
+						next = append(next, embedded{typ: ft, index: index, path: path})
+						continue
+					}
+				} else if !sf.IsExported() {
+					continue
+				}
+				name := tag
+				if name == "" {
+					name = sf.Name
+				}
+				if _, exists := level[name]; !exists {
+					names = append(names, name)
+				}
+				level[name] = append(level[name], jsonField{name: name, index: index, path: path, tagged: tag != ""})
+			}
+		}
+		for _, name := range names {
+			if hidden[name] {
+				continue
+			}
+			hidden[name] = true
+			if field, ok := dominantField(level[name]); ok {
+				fields = append(fields, field)
+			}
+		}
+		current = next
+	}
+	return fields
+}
+
+// dominantField returns the only field, or the only tagged field of fields at the same depth.
+func dominantField(fields []jsonField) (jsonField, bool) {
+	if len(fields) == 1 {
+		return fields[0], true
+	}
+	var tagged []jsonField
+	for _, f := range fields {
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return jsonField{}, false
+}
+
+// fieldByIndex returns the field of v at index, allocating nil embedded pointers on the way.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// lookupKey returns the value of name in m. If m has no exact key,
+// the first key in sorted order which matches name case-insensitively is used.
+func lookupKey(m map[string]any, name string) (any, bool) {
+	if v, ok := m[name]; ok {
+		return v, true
+	}
+	var keys []string
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, false
+	}
+	sort.Strings(keys)
+	return m[keys[0]], true
+}
+
+// convertNumber converts raw, a Go number or json.Number, to typ.
+// An integer type rejects a number with fractional part, and every type rejects a number out of its range.
+func convertNumber(raw any, typ reflect.Type) (reflect.Value, error) {
+	i, f, err := parseNumber(raw)
+	if err != nil {
+		return reflect.Value{}, invalidType(raw, typ)
+	}
+	v := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i == nil {
+			if i, err = integral(f); err != nil {
+				return reflect.Value{}, err
+			}
+		}
+		if !i.IsInt64() || v.OverflowInt(i.Int64()) {
+			return reflect.Value{}, ErrNumberOverflow
+		}
+		v.SetInt(i.Int64())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if i == nil {
+			if i, err = integral(f); err != nil {
+				return reflect.Value{}, err
+			}
+		}
+		if !i.IsUint64() || v.OverflowUint(i.Uint64()) {
+			return reflect.Value{}, ErrNumberOverflow
+		}
+		v.SetUint(i.Uint64())
+	default:
+		if i != nil {
+			f, _ = new(big.Float).SetInt(i).Float64()
+		}
+		if math.IsInf(f, 0) || v.OverflowFloat(f) {
+			return reflect.Value{}, ErrNumberOverflow
+		}
+		v.SetFloat(f)
+	}
+	return v, nil
+}
+
+// parseNumber returns raw as an exact integer if possible, otherwise as a float.
+func parseNumber(raw any) (*big.Int, float64, error) {
+	switch n := raw.(type) {
+	case json.Number:
+		if i, ok := new(big.Int).SetString(string(n), 10); ok {
+			return i, 0, nil
+		}
+		f, err := strconv.ParseFloat(string(n), 64)
+		if err != nil && !math.IsInf(f, 0) {
+			return nil, 0, err
+		}
+		return nil, f, nil
+	case float64:
+		if math.IsNaN(n) {
+			return nil, 0, ErrNotFinite
+		}
+		return nil, n, nil
+	case float32:
+		return parseNumber(float64(n))
+	}
+	rv := reflect.ValueOf(raw)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(rv.Uint()), 0, nil
+	}
+	return nil, 0, ErrInvalidType
+}
+
+func integral(f float64) (*big.Int, error) {
+	if math.IsInf(f, 0) {
+		return nil, ErrNumberOverflow
+	}
+	if f != math.Trunc(f) {
+		return nil, ErrFractional
+	}
+	i, _ := big.NewFloat(f).Int(nil)
+	return i, nil
+}
+
+func invalidType(raw any, typ reflect.Type) error {
+	return fmt.Errorf("input %T type, but expected %s: %w", raw, typ, ErrInvalidType)
+}
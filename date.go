@@ -0,0 +1,223 @@
+package svalidator
+
+import (
+	"time"
+)
+
+// Date is a civil date without time of day and location.
+// The zero value is not a valid date.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in the location of t.
+// Use t.In(loc) to take the date in another location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate parses a date in "2006-01-02" format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, ErrInvalidDate
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in "2006-01-02" format.
+// The zero value is formatted as an empty string.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.In(time.UTC).Format(time.DateOnly)
+}
+
+// IsZero reports whether d is the zero value.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether d is an existing calendar date.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// In returns the midnight at the start of d in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// AddDays returns the date n days after d.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// Compare returns -1 if d is before u, 1 if d is after u and 0 if they are the same date.
+func (d Date) Compare(u Date) int {
+	switch {
+	case d.Year != u.Year:
+		return compareInt(d.Year, u.Year)
+	case d.Month != u.Month:
+		return compareInt(int(d.Month), int(u.Month))
+	}
+	return compareInt(d.Day, u.Day)
+}
+
+// Before reports whether d is before u.
+func (d Date) Before(u Date) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether d is after u.
+func (d Date) After(u Date) bool {
+	return d.Compare(u) > 0
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty text is unmarshalled to the zero value.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+	date, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// DateValidator is a validator for Date.
+// A non-zero input which is not an existing calendar date fails with ErrInvalidDate.
+type DateValidator struct {
+	*Validator[Date]
+}
+
+func CivilDate() *DateValidator {
+	return &DateValidator{
+		Validator: New(func(value Date) error {
+			if !value.IsZero() && !value.IsValid() {
+				return ErrInvalidDate
+			}
+			return nil
+		}),
+	}
+}
+
+// After adds a validate whether input is after target.
+func (d *DateValidator) After(target func() Date) *DateValidator {
+	return d.AppendValidate(func(value Date) error {
+		if value.After(target()) {
+			return nil
+		}
+		return ErrTooSmall
+	})
+}
+
+// EqOrAfter adds a validate whether input is after or equal to target.
+func (d *DateValidator) EqOrAfter(target func() Date) *DateValidator {
+	return d.AppendValidate(func(value Date) error {
+		if value.Before(target()) {
+			return ErrTooSmall
+		}
+		return nil
+	})
+}
+
+// Before adds a validate whether input is before target.
+func (d *DateValidator) Before(target func() Date) *DateValidator {
+	return d.AppendValidate(func(value Date) error {
+		if value.Before(target()) {
+			return nil
+		}
+		return ErrTooBig
+	})
+}
+
+// EqOrBefore adds a validate whether input is before or equal to target.
+func (d *DateValidator) EqOrBefore(target func() Date) *DateValidator {
+	return d.AppendValidate(func(value Date) error {
+		if value.After(target()) {
+			return ErrTooBig
+		}
+		return nil
+	})
+}
+
+// Equal adds a validate whether input is target.
+func (d *DateValidator) Equal(target func() Date) *DateValidator {
+	return d.AppendValidate(func(value Date) error {
+		if value != target() {
+			return ErrNotEqual
+		}
+		return nil
+	})
+}
+
+// Required adds a validate whether input is not the zero value.
+func (d *DateValidator) Required() *DateValidator {
+	return d.AppendValidate(func(value Date) error {
+		if value.IsZero() {
+			return ErrEmpty
+		}
+		return nil
+	})
+}
+
+// Weekday adds a validate whether input is one of days.
+func (d *DateValidator) Weekday(days ...time.Weekday) *DateValidator {
+	return d.onTime(weekday(days))
+}
+
+// NotHoliday adds a validate whether input is not a holiday of calendar.
+func (d *DateValidator) NotHoliday(calendar HolidayCalendar) *DateValidator {
+	return d.onTime(notHoliday(calendar))
+}
+
+// BusinessDay adds a validate whether input is a weekday from Monday to Friday and not a holiday of calendar.
+func (d *DateValidator) BusinessDay(calendar HolidayCalendar) *DateValidator {
+	return d.onTime(weekday(businessWeekdays)).onTime(notHoliday(calendar))
+}
+
+func (d *DateValidator) onTime(f Validate[time.Time]) *DateValidator {
+	return d.AppendValidate(func(value Date) error {
+		return f(value.In(time.UTC))
+	})
+}
+
+func (d *DateValidator) AppendValidate(funcs ...Validate[Date]) *DateValidator {
+	d.Validator = d.Validator.AppendValidate(funcs...)
+	return d
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (d *DateValidator) AppendValidator(inner ValueValidator[Date]) *DateValidator {
+	d.Validator = d.Validator.AppendValidator(inner)
+	return d
+}
@@ -0,0 +1,105 @@
+package svalidator_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestDate(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	d, err := svalidator.ParseDate("2024-02-29")
+	assertError(t, nil, err)
+	if want := (svalidator.Date{Year: 2024, Month: time.February, Day: 29}); d != want {
+		t.Fatalf("want %v, got %v", want, d)
+	}
+	if _, err := svalidator.ParseDate("2023-02-29"); err == nil {
+		t.Errorf("invalid date is parsed")
+	}
+	if got := d.String(); got != "2024-02-29" {
+		t.Errorf("string is %s", got)
+	}
+	if got := d.AddDays(1); got.String() != "2024-03-01" {
+		t.Errorf("add days is %s", got)
+	}
+	if d.Weekday() != time.Thursday {
+		t.Errorf("weekday is %s", d.Weekday())
+	}
+	if !d.Before(d.AddDays(1)) || !d.After(d.AddDays(-1)) || d.Compare(d) != 0 {
+		t.Errorf("compare is wrong")
+	}
+	if got := d.In(tokyo); !got.Equal(time.Date(2024, 2, 28, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("in is %s", got)
+	}
+	if got := svalidator.DateOf(time.Date(2024, 2, 28, 15, 0, 0, 0, time.UTC).In(tokyo)); got != d {
+		t.Errorf("date of is %s", got)
+	}
+
+	var v struct{ Birthday svalidator.Date }
+	assertError(t, nil, json.Unmarshal([]byte(`{"Birthday":"2000-01-02"}`), &v))
+	b, err := json.Marshal(v)
+	assertError(t, nil, err)
+	if string(b) != `{"Birthday":"2000-01-02"}` {
+		t.Errorf("json is %s", b)
+	}
+	assertError(t, svalidator.ErrInvalidDate, json.Unmarshal([]byte(`{"Birthday":"2000-13-01"}`), &v))
+
+	var zero struct{ Birthday svalidator.Date }
+	b, err = json.Marshal(zero)
+	assertError(t, nil, err)
+	if string(b) != `{"Birthday":""}` {
+		t.Errorf("json of zero is %s", b)
+	}
+	v.Birthday = d
+	assertError(t, nil, json.Unmarshal(b, &v))
+	if !v.Birthday.IsZero() {
+		t.Errorf("zero is unmarshalled to %s", v.Birthday)
+	}
+	v.Birthday = d
+	assertError(t, nil, json.Unmarshal([]byte(`{"Birthday":null}`), &v))
+	if v.Birthday != d {
+		t.Errorf("null changes the date to %s", v.Birthday)
+	}
+}
+
+func TestDate_Validate(t *testing.T) {
+	target := func() svalidator.Date { return svalidator.Date{Year: 2024, Month: time.June, Day: 1} }
+	type (
+		args struct {
+			validator *svalidator.DateValidator
+			input     svalidator.Date
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"zero", args{svalidator.CivilDate(), svalidator.Date{}}, nil},
+		{"invalid", args{svalidator.CivilDate(), svalidator.Date{Year: 2024, Month: time.April, Day: 31}}, svalidator.ErrInvalidDate},
+		{"required", args{svalidator.CivilDate().Required(), svalidator.Date{}}, svalidator.ErrEmpty},
+		{"after", args{svalidator.CivilDate().After(target), target().AddDays(1)}, nil},
+		{"after error", args{svalidator.CivilDate().After(target), target()}, svalidator.ErrTooSmall},
+		{"eq or after", args{svalidator.CivilDate().EqOrAfter(target), target()}, nil},
+		{"eq or after error", args{svalidator.CivilDate().EqOrAfter(target), target().AddDays(-1)}, svalidator.ErrTooSmall},
+		{"before", args{svalidator.CivilDate().Before(target), target().AddDays(-1)}, nil},
+		{"before error", args{svalidator.CivilDate().Before(target), target()}, svalidator.ErrTooBig},
+		{"eq or before", args{svalidator.CivilDate().EqOrBefore(target), target()}, nil},
+		{"eq or before error", args{svalidator.CivilDate().EqOrBefore(target), target().AddDays(1)}, svalidator.ErrTooBig},
+		{"equal", args{svalidator.CivilDate().Equal(target), target()}, nil},
+		{"equal error", args{svalidator.CivilDate().Equal(target), target().AddDays(1)}, svalidator.ErrNotEqual},
+		{"weekday", args{svalidator.CivilDate().Weekday(time.Saturday), target()}, nil},
+		{"weekday error", args{svalidator.CivilDate().Weekday(time.Sunday), target()}, svalidator.ErrNotAllowedWeekday},
+		{"business day", args{svalidator.CivilDate().BusinessDay(svalidator.JapaneseHolidays), target().AddDays(2)}, nil},
+		{"business day on holiday", args{svalidator.CivilDate().BusinessDay(svalidator.JapaneseHolidays), svalidator.Date{Year: 2024, Month: time.May, Day: 6}}, svalidator.ErrHoliday},
+		{"not holiday", args{svalidator.CivilDate().NotHoliday(svalidator.JapaneseHolidays), svalidator.Date{Year: 2024, Month: time.January, Day: 1}}, svalidator.ErrHoliday},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package svalidator
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// DecimalValidator is a validator for decimal strings such as "12345.6700".
+// Every rule checks that input is a decimal string without exponent.
+type DecimalValidator[T ~string] struct {
+	*Validator[T]
+}
+
+// Decimal returns DecimalValidator.
+func Decimal[T ~string]() *DecimalValidator[T] {
+	return &DecimalValidator[T]{
+		Validator: New(func(value T) error {
+			if _, ok := parseDecimal(string(value)); !ok {
+				return ErrInvalidDecimal
+			}
+			return nil
+		}),
+	}
+}
+
+// Min adds a validate whether input is greater than or equal to num.
+// It panics if num is not a decimal string. The error is *ErrBound.
+func (d *DecimalValidator[T]) Min(num string) *DecimalValidator[T] {
+	return d.compare(num, func(c int) bool { return c >= 0 }, &ErrBound{Err: ErrTooSmall, Bound: num, Inclusive: true})
+}
+
+// Max adds a validate whether input is less than or equal to num.
+// It panics if num is not a decimal string. The error is *ErrBound.
+func (d *DecimalValidator[T]) Max(num string) *DecimalValidator[T] {
+	return d.compare(num, func(c int) bool { return c <= 0 }, &ErrBound{Err: ErrTooBig, Bound: num, Inclusive: true})
+}
+
+// GreaterThan adds a validate whether input is greater than num.
+// It panics if num is not a decimal string. The error is *ErrBound.
+func (d *DecimalValidator[T]) GreaterThan(num string) *DecimalValidator[T] {
+	return d.compare(num, func(c int) bool { return c > 0 }, &ErrBound{Err: ErrTooSmall, Bound: num})
+}
+
+// LessThan adds a validate whether input is less than num.
+// It panics if num is not a decimal string. The error is *ErrBound.
+func (d *DecimalValidator[T]) LessThan(num string) *DecimalValidator[T] {
+	return d.compare(num, func(c int) bool { return c < 0 }, &ErrBound{Err: ErrTooBig, Bound: num})
+}
+
+// Precision adds a validate whether input fits SQL NUMERIC(precision, scale).
+// Input may have at most precision-scale integer digits and scale fractional digits.
+// Trailing zeros of the fractional part are not counted.
+func (d *DecimalValidator[T]) Precision(precision, scale int) *DecimalValidator[T] {
+	return d.AppendValidate(func(value T) error {
+		dec, ok := parseDecimal(string(value))
+		if !ok {
+			return nil
+		}
+		if len(strings.TrimRight(dec.frac, "0")) > scale {
+			return ErrTooManyDecimals
+		}
+		if len(strings.TrimLeft(dec.int, "0")) > precision-scale {
+			return ErrTooManyDigits
+		}
+		return nil
+	})
+}
+
+// MaxDecimalPlaces adds a validate whether input has at most places digits after the decimal point.
+// Unlike Precision, trailing zeros are counted.
+func (d *DecimalValidator[T]) MaxDecimalPlaces(places int) *DecimalValidator[T] {
+	return d.AppendValidate(func(value T) error {
+		if dec, ok := parseDecimal(string(value)); ok && len(dec.frac) > places {
+			return ErrTooManyDecimals
+		}
+		return nil
+	})
+}
+
+// Positive adds a validate whether input is greater than zero.
+func (d *DecimalValidator[T]) Positive() *DecimalValidator[T] {
+	return d.GreaterThan("0")
+}
+
+// Negative adds a validate whether input is less than zero.
+func (d *DecimalValidator[T]) Negative() *DecimalValidator[T] {
+	return d.LessThan("0")
+}
+
+// NonZero adds a validate whether input is not zero.
+func (d *DecimalValidator[T]) NonZero() *DecimalValidator[T] {
+	return d.AppendValidate(func(value T) error {
+		if dec, ok := parseDecimal(string(value)); ok && dec.rat().Sign() == 0 {
+			return ErrZero
+		}
+		return nil
+	})
+}
+
+// Canonical adds a validate whether input is in the canonical form,
+// which has no plus sign, no redundant zeros and no negative zero.
+func (d *DecimalValidator[T]) Canonical() *DecimalValidator[T] {
+	return d.AppendValidate(func(value T) error {
+		dec, ok := parseDecimal(string(value))
+		if !ok {
+			return nil
+		}
+		if strings.HasPrefix(string(value), "+") ||
+			len(dec.int) > 1 && dec.int[0] == '0' ||
+			strings.HasSuffix(dec.frac, "0") ||
+			dec.neg && dec.rat().Sign() == 0 {
+			return ErrNotCanonical
+		}
+		return nil
+	})
+}
+
+func (d *DecimalValidator[T]) AppendValidate(funcs ...Validate[T]) *DecimalValidator[T] {
+	d.Validator = d.Validator.AppendValidate(funcs...)
+	return d
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (d *DecimalValidator[T]) AppendValidator(inner ValueValidator[T]) *DecimalValidator[T] {
+	d.Validator = d.Validator.AppendValidator(inner)
+	return d
+}
+
+func (d *DecimalValidator[T]) compare(num string, ok func(int) bool, err error) *DecimalValidator[T] {
+	bound, valid := parseDecimal(num)
+	if !valid {
+		panic(fmt.Sprintf("%q is not a decimal", num))
+	}
+	target := bound.rat()
+	return d.AppendValidate(func(value T) error {
+		if dec, valid := parseDecimal(string(value)); valid && !ok(dec.rat().Cmp(target)) {
+			return err
+		}
+		return nil
+	})
+}
+
+type decimal struct {
+	neg  bool
+	int  string
+	frac string
+}
+
+// parseDecimal parses s such as "-12.340".
+func parseDecimal(s string) (decimal, bool) {
+	var d decimal
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		d.neg = s[0] == '-'
+		s = s[1:]
+	}
+	d.int, d.frac = s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		d.int, d.frac = s[:i], s[i+1:]
+		if len(d.frac) == 0 {
+			return d, false
+		}
+	}
+	if len(d.int) == 0 || !isDigits(d.int) || !isDigits(d.frac) {
+		return d, false
+	}
+	return d, true
+}
+
+func (d decimal) rat() *big.Rat {
+	s := d.int
+	if d.frac != "" {
+		s += "." + d.frac
+	}
+	if d.neg {
+		s = "-" + s
+	}
+	r, _ := new(big.Rat).SetString(s)
+	return r
+}
@@ -0,0 +1,71 @@
+package svalidator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestDecimal_Validate(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.DecimalValidator[string]
+			input     string
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"pass", args{svalidator.Decimal[string](), "-12345.6700"}, nil},
+		{"invalid exponent", args{svalidator.Decimal[string](), "1e10"}, svalidator.ErrInvalidDecimal},
+		{"invalid dot", args{svalidator.Decimal[string](), "1."}, svalidator.ErrInvalidDecimal},
+		{"invalid empty", args{svalidator.Decimal[string](), ""}, svalidator.ErrInvalidDecimal},
+		{"min", args{svalidator.Decimal[string]().Min("0.01"), "0.010"}, nil},
+		{"too small", args{svalidator.Decimal[string]().Min("0.01"), "0.009"}, svalidator.ErrTooSmall},
+		{"too big", args{svalidator.Decimal[string]().Max("99999999999999999999.99"), "100000000000000000000"}, svalidator.ErrTooBig},
+		{"not greater than", args{svalidator.Decimal[string]().GreaterThan("1"), "1.0"}, svalidator.ErrTooSmall},
+		{"not less than", args{svalidator.Decimal[string]().LessThan("1"), "1.00"}, svalidator.ErrTooBig},
+		{"precision", args{svalidator.Decimal[string]().Precision(6, 2), "1234.5600"}, nil},
+		{"precision scale", args{svalidator.Decimal[string]().Precision(6, 2), "1.234"}, svalidator.ErrTooManyDecimals},
+		{"precision digits", args{svalidator.Decimal[string]().Precision(6, 2), "12345.6"}, svalidator.ErrTooManyDigits},
+		{"decimal places", args{svalidator.Decimal[string]().MaxDecimalPlaces(2), "1.230"}, svalidator.ErrTooManyDecimals},
+		{"positive", args{svalidator.Decimal[string]().Positive(), "0.1"}, nil},
+		{"not positive", args{svalidator.Decimal[string]().Positive(), "-0.0"}, svalidator.ErrTooSmall},
+		{"not negative", args{svalidator.Decimal[string]().Negative(), "0"}, svalidator.ErrTooBig},
+		{"zero", args{svalidator.Decimal[string]().NonZero(), "0.000"}, svalidator.ErrZero},
+		{"canonical", args{svalidator.Decimal[string]().Canonical(), "-0.5"}, nil},
+		{"plus sign", args{svalidator.Decimal[string]().Canonical(), "+1"}, svalidator.ErrNotCanonical},
+		{"leading zero", args{svalidator.Decimal[string]().Canonical(), "01"}, svalidator.ErrNotCanonical},
+		{"trailing zero", args{svalidator.Decimal[string]().Canonical(), "1.10"}, svalidator.ErrNotCanonical},
+		{"negative zero", args{svalidator.Decimal[string]().Canonical(), "-0"}, svalidator.ErrNotCanonical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestDecimal_Bound(t *testing.T) {
+	err := svalidator.Decimal[string]().GreaterThan("10.5").Validate("10")
+	var berr *svalidator.ErrBound
+	if !errors.As(err, &berr) || berr.Bound != "10.5" || berr.Inclusive {
+		t.Fatalf("want bound error, but got: %v", err)
+	}
+	if want := "input value is too small: must be > 10.5"; berr.Error() != want {
+		t.Errorf("want: %s.\nbut got: %s", want, berr.Error())
+	}
+}
+
+func TestDecimal_InvalidBound(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("want panic")
+		}
+	}()
+	svalidator.Decimal[string]().Min("1e3")
+}
@@ -0,0 +1,88 @@
+package svalidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// Decode converts input into T and validates it by v.
+//
+// input is a map[string]any decoded from JSON, or JSON bytes of an object.
+// Keys are matched to the fields by the name of json tag or the field name, case-insensitively as encoding/json.
+// Numbers are converted to numeric fields rejecting overflow and fractional part,
+// and strings are converted to fields implementing encoding.TextUnmarshaler such as time.Time and Date.
+//
+// Conversion errors and validation errors are returned together as ErrObject.
+// Fields which failed conversion are not reported again by validation.
+// On error, the zero value of T is returned.
+func Decode[T any, I map[string]any | []byte](input I, v *ObjectValidator[T]) (T, error) {
+	return DecodeContext(context.Background(), input, v)
+}
+
+// DecodeContext is Decode with ctx passed to the validation.
+func DecodeContext[T any, I map[string]any | []byte](ctx context.Context, input I, v *ObjectValidator[T]) (T, error) {
+	var zero T
+	var m map[string]any
+	switch in := any(input).(type) {
+	case map[string]any:
+		m = in
+	case []byte:
+		dec := json.NewDecoder(bytes.NewReader(in))
+		dec.UseNumber()
+		if err := dec.Decode(&m); err != nil {
+			return zero, err
+		}
+		if dec.More() {
+			return zero, errors.New("invalid data after top-level JSON value")
+		}
+	}
+
+	var value T
+	convErr := decodeStruct(reflect.ValueOf(&value).Elem(), m)
+	verr := unwrapValidate(v.ValidateContext(ctx, value))
+	if isAbort(verr) {
+		return zero, verr
+	}
+	if err := mergeErrObject(convErr, verr); err != nil {
+		if errs, ok := err.(ErrObject); ok {
+			v.order.sort(errs, reflect.TypeOf(value))
+		}
+		return zero, err
+	}
+	return value, nil
+}
+
+// mergeErrObject adds the fields of err to fields which are not already in fields.
+func mergeErrObject(fields ErrObject, err error) error {
+	if err == nil {
+		return newErrObject(fields...)
+	}
+	var errs ErrObject
+	if !errors.As(err, &errs) {
+		if len(fields) == 0 {
+			return err
+		}
+		return append(fields, newErrObjectField("", err))
+	}
+
+	merged := append(ErrObject(nil), fields...)
+	index := make(map[string]int, len(fields))
+	for i, f := range fields {
+		index[f.Field] = i
+	}
+	for _, f := range errs {
+		i, exists := index[f.Field]
+		if !exists {
+			merged = append(merged, f)
+			continue
+		}
+		var nested ErrObject
+		if errors.As(unwrapValidate(merged[i].Err), &nested) {
+			merged[i] = newErrObjectField(f.Field, mergeErrObject(nested, unwrapValidate(f.Err)))
+		}
+	}
+	return merged
+}
@@ -0,0 +1,207 @@
+package svalidator_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestDecode(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+	}
+	type User struct {
+		ID       int64                `json:"id"`
+		Name     string               `json:"name"`
+		Score    float32              `json:"score"`
+		Age      uint8                `json:"age"`
+		Birthday svalidator.Date      `json:"birthday"`
+		Created  time.Time            `json:"created_at"`
+		Nickname *string              `json:"nickname"`
+		Tags     []string             `json:"tags"`
+		Address  Address              `json:"address"`
+		Extra    map[string]int       `json:"extra"`
+		Open     svalidator.TimeOfDay `json:"open"`
+		Active   bool
+		Ignored  string `json:"-"`
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[User]{
+		"ID":   svalidator.Number[int64]().Min(1),
+		"Name": svalidator.String().Required(),
+		"Age":  svalidator.Number[uint8]().Max(150),
+		"Tags": svalidator.Slice[string](svalidator.String().Required()),
+		"Address": svalidator.Object(svalidator.ValidatorMap[Address]{
+			"City": svalidator.String().Required(),
+		}),
+	})
+
+	t.Run("json", func(t *testing.T) {
+		got, err := svalidator.Decode([]byte(`{
+			"id": 9007199254740993,
+			"name": "taro",
+			"score": 1.5,
+			"age": 20,
+			"birthday": "2000-01-02",
+			"created_at": "2024-06-01T09:00:00+09:00",
+			"nickname": "t",
+			"tags": ["a"],
+			"address": {"city": "Tokyo"},
+			"extra": {"x": 1},
+			"open": "10:00",
+			"ACTIVE": true,
+			"Ignored": "x",
+			"unknown": 1
+		}`), v)
+		assertError(t, nil, err)
+		if got.ID != 9007199254740993 || got.Name != "taro" || got.Score != 1.5 || got.Age != 20 ||
+			got.Birthday.String() != "2000-01-02" || !got.Created.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) ||
+			*got.Nickname != "t" || got.Tags[0] != "a" || got.Address.City != "Tokyo" || got.Extra["x"] != 1 ||
+			got.Open.Hour != 10 || !got.Active || got.Ignored != "" {
+			t.Errorf("unexpected result %+v", got)
+		}
+	})
+
+	t.Run("map", func(t *testing.T) {
+		got, err := svalidator.Decode(map[string]any{
+			"id":      float64(1),
+			"name":    "taro",
+			"tags":    []any{},
+			"address": map[string]any{"city": "Tokyo"},
+		}, v)
+		assertError(t, nil, err)
+		if got.ID != 1 || got.Name != "taro" {
+			t.Errorf("unexpected result %+v", got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		got, err := svalidator.Decode(map[string]any{
+			"id":       1.5,
+			"name":     "",
+			"age":      float64(256),
+			"birthday": "2000-02-30",
+			"tags":     []any{"a", 1, ""},
+			"address":  map[string]any{"city": 1},
+		}, v)
+		assertError(t, svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "ID", Err: svalidator.ErrFractional},
+			&svalidator.ErrObjectField{Field: "Age", Err: svalidator.ErrNumberOverflow},
+			&svalidator.ErrObjectField{Field: "Birthday", Err: svalidator.ErrInvalidDate},
+			&svalidator.ErrObjectField{Field: "Tags", Err: svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "1", Err: svalidator.ErrInvalidType},
+				&svalidator.ErrObjectField{Field: "2", Err: svalidator.ErrEmpty},
+			}},
+			&svalidator.ErrObjectField{Field: "Address", Err: svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "City", Err: svalidator.ErrInvalidType},
+			}},
+			&svalidator.ErrObjectField{Field: "Name", Err: svalidator.ErrEmpty},
+		}, err)
+		var oerr svalidator.ErrObject
+		if !errors.As(err, &oerr) || len(oerr) != 6 {
+			t.Errorf("unexpected errors %v", err)
+		}
+		if got.Name != "" || got.Tags != nil {
+			t.Errorf("partial value is returned %+v", got)
+		}
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := svalidator.Decode([]byte(`{"id": 1} {}`), v)
+		assertIsError(t, true, err)
+		_, err = svalidator.Decode([]byte(`[]`), v)
+		assertIsError(t, true, err)
+	})
+}
+
+func TestDecode_Number(t *testing.T) {
+	type Numbers struct {
+		Int8    int8
+		Uint    uint
+		Float32 float32
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[Numbers]{})
+	for _, tt := range []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"pass", `{"Int8": -128, "Uint": 1e3, "Float32": 1.5}`, nil},
+		{"int overflow", `{"Int8": 128}`, svalidator.ErrNumberOverflow},
+		{"huge int", `{"Int8": 1e400}`, svalidator.ErrNumberOverflow},
+		{"negative uint", `{"Uint": -1}`, svalidator.ErrNumberOverflow},
+		{"fraction", `{"Uint": 1.5}`, svalidator.ErrFractional},
+		{"float overflow", `{"Float32": 1e39}`, svalidator.ErrNumberOverflow},
+		{"string", `{"Int8": "1"}`, svalidator.ErrInvalidType},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svalidator.Decode([]byte(tt.input), v)
+			if tt.err == nil {
+				assertError(t, nil, err)
+				return
+			}
+			var oerr svalidator.ErrObject
+			if !errors.As(err, &oerr) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			assertError(t, tt.err, oerr[0].Err)
+		})
+	}
+}
+
+type decodeBase struct {
+	ID int `json:"id"`
+}
+
+type DecodeAudit struct {
+	CreatedBy string
+}
+
+func TestDecode_Embedded(t *testing.T) {
+	type Item struct {
+		decodeBase
+		*DecodeAudit
+		Name string `json:"name"`
+		// hides ID of decodeBase.
+		Code string `json:"id"`
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[Item]{
+		"Name": svalidator.String().Required(),
+	})
+
+	got, err := svalidator.Decode([]byte(`{"id":"c","createdby":"user","name":"item"}`), v)
+	assertError(t, nil, err)
+	if got.ID != 0 || got.Code != "c" || got.DecodeAudit == nil || got.CreatedBy != "user" || got.Name != "item" {
+		t.Errorf("decoded is %+v", got)
+	}
+
+	type Named struct {
+		decodeBase
+		Name string `json:"name"`
+	}
+	_, err = svalidator.Decode([]byte(`{"id":"x","name":"n"}`), svalidator.Object(svalidator.ValidatorMap[Named]{}))
+	var errs svalidator.ErrObject
+	if !errors.As(err, &errs) || !errs.HasCode("decodeBase.ID", svalidator.ErrInvalidType) {
+		t.Errorf("want invalid type of decodeBase.ID, but got: %v", err)
+	}
+}
+
+func TestDecode_CaseInsensitiveKey(t *testing.T) {
+	type User struct {
+		Name string
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[User]{})
+	for i := 0; i < 20; i++ {
+		got, err := svalidator.Decode(map[string]any{"nAme": "b", "NAME": "a", "naMe": "c"}, v)
+		assertError(t, nil, err)
+		if got.Name != "a" {
+			t.Fatalf("want the first key in sorted order, but got: %s", got.Name)
+		}
+	}
+	got, err := svalidator.Decode(map[string]any{"NAME": "a", "Name": "exact"}, v)
+	assertError(t, nil, err)
+	if got.Name != "exact" {
+		t.Errorf("want the exact key, but got: %s", got.Name)
+	}
+}
@@ -40,6 +40,27 @@ Of course, each validator can also be used directly.
 	 	validator := svalidator.Number[int]().Min(2).Max(4)
 		err := validator.Validate(4)
 
+# Pointer
+
+Any validator can be lifted to a pointer type by [Optional].
+By default nil input passes, and the policy can be changed by [NilPolicy].
+
+	validator := svalidator.Optional[string](svalidator.String().Max(255)).Required()
+	err := validator.Validate(nil) // ErrEmpty
+
+# External Lookup
+
+[Lookup] creates a validator which needs I/O such as a database query.
+When it is used in an object or a slice, all keys in the input are looked up in batches before the validation.
+
+	var categoryExists = svalidator.Lookup(func(ctx context.Context, ids []string) ([]error, error) {
+		// query all ids at once and return an error for each id.
+	}).BatchSize(100).Concurrency(4).Cache()
+
+	err := svalidator.Slice[string](categoryExists).ValidateContext(ctx, ids)
+
+If the lookup fails as a whole, the validation is aborted with [ErrLookup].
+
 # Custom Validator
 
 Also, you can use your definition in two way.
@@ -0,0 +1,203 @@
+package svalidator
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ArrayValidator is a validator for an array of a JSON document.
+type ArrayValidator struct {
+	*Validator[[]any]
+	inner    AnyValidator
+	nullable bool
+	doc      docOptions
+}
+
+// Array returns ArrayValidator which validates each element by inner.
+// Elements are converted to the input type of inner by the coercion policy like MapValidator,
+// and null elements fail with ErrEmpty.
+// Wrap inner by Key with KeyNullable to skip null elements. The other policies are ignored, because elements are always present.
+// Errors of elements are returned as ErrObject whose field is the index.
+func Array(inner AnyValidator) *ArrayValidator {
+	inner, presence := keyPolicy(inner)
+	a := &ArrayValidator{inner: inner, nullable: presence&KeyNullable != 0}
+	a.Validator = NewContext(func(ctx context.Context, value []any) error {
+		ctx, level, err := enterDocument(ctx, a.doc, len(value))
+		if err != nil {
+			return err
+		}
+		ctx, err = prefetchLookup(ctx, a, value)
+		if err != nil {
+			return err
+		}
+		arg := validateArgType(inner)
+		var merr []*ErrObjectField
+		for i, elem := range value {
+			if elem == nil {
+				if !a.nullable {
+					merr = append(merr, newErrObjectField(strconv.Itoa(i), ErrEmpty))
+				}
+				continue
+			}
+			elem, err := coerceValue(elem, arg, level.coercion)
+			if err == nil {
+				err = inner.validateAny(withField(ctx, strconv.Itoa(i)), elem)
+			}
+			if isAbort(err) {
+				return err
+			} else if err != nil {
+				merr = append(merr, newErrObjectField(strconv.Itoa(i), err))
+			}
+		}
+		err = newErrObject(merr...)
+		if level.depth == 1 {
+			setPointers(err, "")
+		}
+		return err
+	})
+	return a
+}
+
+// Max adds a validate whether the length of input is less than or equal to m.
+func (a *ArrayValidator) Max(m int) *ArrayValidator {
+	return a.AppendValidate(func(value []any) error {
+		if len(value) > m {
+			return ErrTooBig
+		}
+		return nil
+	})
+}
+
+// Min adds a validate whether the length of input is greater than or equal to m.
+func (a *ArrayValidator) Min(m int) *ArrayValidator {
+	return a.AppendValidate(func(value []any) error {
+		if len(value) < m {
+			return ErrTooSmall
+		}
+		return nil
+	})
+}
+
+func (a *ArrayValidator) Required() *ArrayValidator {
+	return a.AppendValidate(func(value []any) error {
+		if len(value) == 0 {
+			return ErrEmpty
+		}
+		return nil
+	})
+}
+
+// Coerce sets the policy for converting elements to the input type of inner.
+func (a *ArrayValidator) Coerce(coercion Coercion) *ArrayValidator {
+	a.doc.coercion = coercion
+	return a
+}
+
+// Limits sets the maximum nesting depth and the maximum number of keys and elements in the whole document.
+// Zero means no limit.
+func (a *ArrayValidator) Limits(maxDepth, maxElements int) *ArrayValidator {
+	a.doc.maxDepth, a.doc.maxElements = maxDepth, maxElements
+	return a
+}
+
+func (a *ArrayValidator) AppendValidate(funcs ...Validate[[]any]) *ArrayValidator {
+	a.Validator = a.Validator.AppendValidate(funcs...)
+	return a
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (a *ArrayValidator) AppendValidator(inner ValueValidator[[]any]) *ArrayValidator {
+	a.Validator = a.Validator.AppendValidator(inner)
+	return a
+}
+
+func (a *ArrayValidator) hasLookup() bool {
+	c, ok := a.inner.(lookupCollector)
+	return ok && c.hasLookup()
+}
+
+func (a *ArrayValidator) collectLookup(ctx context.Context, run *lookupRun, value any) {
+	ctx, coercion := inheritCoercion(ctx, a.doc.coercion)
+	arg := validateArgType(a.inner)
+	for _, elem := range value.([]any) {
+		if elem == nil {
+			continue
+		}
+		if elem, err := coerceValue(elem, arg, coercion); err == nil {
+			a.inner.(lookupCollector).collectLookup(ctx, run, elem)
+		}
+	}
+}
+
+type docOptions struct {
+	coercion    Coercion
+	maxDepth    int
+	maxElements int
+}
+
+// docLevel is the state of an object or array in a document being validated.
+type docLevel struct {
+	depth    int
+	coercion Coercion
+	doc      *docState
+}
+
+type docState struct {
+	maxDepth    int
+	maxElements int
+	elements    int
+}
+
+type docLevelKey struct{}
+
+// enterDocument returns ctx for validating an object or array with n keys or elements.
+// The validator of the root document, whose depth is 1, sets the limits.
+func enterDocument(ctx context.Context, opts docOptions, n int) (context.Context, *docLevel, error) {
+	level := &docLevel{depth: 1, coercion: opts.coercion}
+	if parent, ok := ctx.Value(docLevelKey{}).(*docLevel); ok {
+		level.depth, level.doc = parent.depth+1, parent.doc
+		if level.coercion == NoCoercion {
+			level.coercion = parent.coercion
+		}
+	} else {
+		level.doc = &docState{maxDepth: opts.maxDepth, maxElements: opts.maxElements}
+	}
+
+	if level.doc.maxDepth > 0 && level.depth > level.doc.maxDepth {
+		return nil, nil, ErrTooDeep
+	}
+	level.doc.elements += n
+	if level.doc.maxElements > 0 && level.doc.elements > level.doc.maxElements {
+		return nil, nil, ErrTooManyElements
+	}
+	return context.WithValue(ctx, docLevelKey{}, level), level, nil
+}
+
+// inheritCoercion returns the coercion of a node whose own policy is own, inheriting the one of the parent in ctx,
+// and ctx for its nested nodes. This is for lookup collection, which runs before enterDocument of nested nodes.
+func inheritCoercion(ctx context.Context, own Coercion) (context.Context, Coercion) {
+	parent, ok := ctx.Value(docLevelKey{}).(*docLevel)
+	switch {
+	case own == NoCoercion && ok:
+		return ctx, parent.coercion
+	case own == NoCoercion:
+		return ctx, own
+	}
+	return context.WithValue(ctx, docLevelKey{}, &docLevel{coercion: own}), own
+}
+
+// setPointers sets the JSON Pointer of each field of err under prefix.
+func setPointers(err error, prefix string) {
+	var errs ErrObject
+	if !errors.As(err, &errs) {
+		return
+	}
+	for _, f := range errs {
+		f.Pointer = prefix + "/" + pointerEscaper.Replace(f.Field)
+		setPointers(f.Err, f.Pointer)
+	}
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
@@ -0,0 +1,161 @@
+package svalidator_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestArray(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		validator *svalidator.ArrayValidator
+		input     []any
+		want      error
+	}{
+		{"pass", svalidator.Array(svalidator.String().Required()).Max(2), []any{"a", "b"}, nil},
+		{"length error", svalidator.Array(svalidator.String()).Max(1), []any{"a", "b"}, svalidator.ErrTooBig},
+		{"required", svalidator.Array(svalidator.String()).Required(), []any{}, svalidator.ErrEmpty},
+		{"element errors", svalidator.Array(svalidator.String().Required()), []any{"a", "", nil, 1}, svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "1", Err: svalidator.ErrEmpty},
+			&svalidator.ErrObjectField{Field: "2", Err: svalidator.ErrEmpty},
+			&svalidator.ErrObjectField{Field: "3", Err: svalidator.ErrInvalidType},
+		}},
+		{"coerce", svalidator.Array(svalidator.Number[int]().Min(1)).Coerce(svalidator.CoerceStrict), []any{float64(1), json.Number("2")}, nil},
+		{"too many elements", svalidator.Array(svalidator.Number[int]()).Limits(0, 2), []any{1, 2, 3}, svalidator.ErrTooManyElements},
+		{"nullable key", svalidator.Array(svalidator.Key(svalidator.String().Required(), svalidator.KeyNullable)), []any{"a", nil, ""}, svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "2", Err: svalidator.ErrEmpty},
+		}},
+		{"optional key", svalidator.Array(svalidator.Key(svalidator.String(), svalidator.KeyOptional)), []any{"a", nil}, svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "1", Err: svalidator.ErrEmpty},
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestMap_Document(t *testing.T) {
+	item := svalidator.Map(svalidator.AnyValidatorMap{
+		"name":  svalidator.String().Required(),
+		"price": svalidator.Number[int]().Min(0),
+	})
+	order := func() *svalidator.MapValidator {
+		return svalidator.Map(svalidator.AnyValidatorMap{
+			"id":    svalidator.String().Required(),
+			"items": svalidator.Array(item).Max(10),
+			"meta": svalidator.Key(svalidator.Map(svalidator.AnyValidatorMap{
+				"a/b": svalidator.Number[int]().Max(1),
+			}), svalidator.KeyOptional),
+		}).Coerce(svalidator.CoerceStrict)
+	}
+	decode := func(s string) map[string]any {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	t.Run("pass", func(t *testing.T) {
+		err := order().Validate(decode(`{"id": "1", "items": [{"name": "a", "price": 100}]}`))
+		assertError(t, nil, err)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		err := order().Validate(decode(`{"id": "1", "items": [{"name": "a", "price": 1}, {"name": "b", "price": 1}, {"name": "c", "price": -1}], "meta": {"a/b": 2}}`))
+		pointers := map[string]error{}
+		collectPointers(err, pointers)
+		if len(pointers) != 2 {
+			t.Fatalf("unexpected pointers %v", pointers)
+		}
+		assertError(t, svalidator.ErrTooSmall, pointers["/items/2/price"])
+		assertError(t, svalidator.ErrTooBig, pointers["/meta/a~1b"])
+	})
+
+	t.Run("nested type error", func(t *testing.T) {
+		err := order().Validate(decode(`{"id": "1", "items": [{"name": 1, "price": 1.5}]}`))
+		pointers := map[string]error{}
+		collectPointers(err, pointers)
+		assertError(t, svalidator.ErrInvalidType, pointers["/items/0/name"])
+		assertError(t, svalidator.ErrFractional, pointers["/items/0/price"])
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		err := order().Limits(2, 0).Validate(decode(`{"id": "1", "items": [{"name": "a", "price": 1}]}`))
+		pointers := map[string]error{}
+		collectPointers(err, pointers)
+		assertError(t, svalidator.ErrTooDeep, pointers["/items/0"])
+	})
+
+	t.Run("max elements", func(t *testing.T) {
+		err := order().Limits(0, 6).Validate(decode(`{"id": "1", "items": [{"name": "a", "price": 1}, {"name": "b", "price": 1}]}`))
+		pointers := map[string]error{}
+		collectPointers(err, pointers)
+		assertError(t, svalidator.ErrTooManyElements, pointers["/items/1"])
+		if _, ok := pointers["/items/0"]; ok {
+			t.Errorf("unexpected pointers %v", pointers)
+		}
+	})
+}
+
+// collectPointers collects errors which are not ErrObject by JSON Pointer.
+func TestMap_DocumentLookup(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls [][]int
+	)
+	lk := svalidator.Lookup(func(ctx context.Context, keys []int) ([]error, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, keys)
+		errs := make([]error, len(keys))
+		for i, key := range keys {
+			if key > 4 {
+				errs[i] = svalidator.ErrNotFound
+			}
+		}
+		return errs, nil
+	})
+	v := svalidator.Map(svalidator.AnyValidatorMap{
+		"ids":   svalidator.Array(lk),
+		"items": svalidator.Array(svalidator.Map(svalidator.AnyValidatorMap{"id": lk})),
+	}).Coerce(svalidator.CoerceStrict)
+
+	var input map[string]any
+	dec := json.NewDecoder(strings.NewReader(`{"ids":[1,2,3],"items":[{"id":4},{"id":5}]}`))
+	dec.UseNumber()
+	if err := dec.Decode(&input); err != nil {
+		t.Fatal(err)
+	}
+	err := v.Validate(input)
+	var errs svalidator.ErrObject
+	if !errors.As(err, &errs) || !errs.HasCode("/items/1/id", svalidator.ErrNotFound) || len(errs.Fields()) != 1 {
+		t.Errorf("want not found of /items/1/id, but got: %v", err)
+	}
+	if len(calls) != 1 || len(calls[0]) != 5 {
+		t.Errorf("want 1 call with 5 keys, but got: %v", calls)
+	}
+}
+
+func collectPointers(err error, pointers map[string]error) {
+	var errs svalidator.ErrObject
+	if !errors.As(err, &errs) {
+		return
+	}
+	for _, f := range errs {
+		var nested svalidator.ErrObject
+		if errors.As(f.Err, &nested) {
+			collectPointers(nested, pointers)
+			continue
+		}
+		pointers[f.Pointer] = f.Err
+	}
+}
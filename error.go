@@ -9,11 +9,81 @@ import (
 var (
 	ErrInvalidType     = fmt.Errorf("input is unexpected type")
 	ErrNotExistsField  = fmt.Errorf("field does not exist")
+	ErrUnknownField    = fmt.Errorf("field is not allowed")
+	ErrUndeclaredGroup = fmt.Errorf("group is not declared")
 	ErrNotEqual        = fmt.Errorf("input value is not equal expected value")
 	ErrEmpty           = fmt.Errorf("input value is required")
+	ErrNotEmpty        = fmt.Errorf("input value must be empty")
 	ErrTooBig          = fmt.Errorf("input value is too big")
 	ErrTooSmall        = fmt.Errorf("input value is too small")
 	ErrMismatchPattern = fmt.Errorf("input value is mismatch expected pattern")
+	ErrNotFound        = fmt.Errorf("input value is not found")
+	ErrAlreadyExists   = fmt.Errorf("input value already exists")
+	ErrZero            = fmt.Errorf("input value must not be zero")
+	ErrNotMultiple     = fmt.Errorf("input value is not a multiple of expected value")
+	ErrNotFinite       = fmt.Errorf("input value is not a finite number")
+	ErrTooManyDecimals = fmt.Errorf("input value has too many decimal places")
+	ErrTooManyDigits   = fmt.Errorf("input value has too many integer digits")
+
+	ErrNotAllowedWeekday = fmt.Errorf("input date is not an allowed weekday")
+	ErrHoliday           = fmt.Errorf("input date is a holiday")
+	ErrOutOfTimeRange    = fmt.Errorf("input time is out of allowed time range")
+	ErrInvalidLocation   = fmt.Errorf("input time has unexpected location")
+	ErrNotTruncated      = fmt.Errorf("input time has finer precision than allowed")
+	ErrMonotonic         = fmt.Errorf("input time has monotonic clock reading")
+)
+
+// Format errors wrap ErrMismatchPattern.
+var (
+	ErrInvalidEmail    = fmt.Errorf("%w: email", ErrMismatchPattern)
+	ErrInvalidURL      = fmt.Errorf("%w: url", ErrMismatchPattern)
+	ErrInvalidUUID     = fmt.Errorf("%w: uuid", ErrMismatchPattern)
+	ErrInvalidULID     = fmt.Errorf("%w: ulid", ErrMismatchPattern)
+	ErrInvalidHostname = fmt.Errorf("%w: hostname", ErrMismatchPattern)
+	ErrInvalidIP       = fmt.Errorf("%w: ip address", ErrMismatchPattern)
+	ErrInvalidIPv4     = fmt.Errorf("%w: ipv4 address", ErrMismatchPattern)
+	ErrInvalidIPv6     = fmt.Errorf("%w: ipv6 address", ErrMismatchPattern)
+	ErrInvalidCIDR     = fmt.Errorf("%w: cidr", ErrMismatchPattern)
+	ErrInvalidMAC      = fmt.Errorf("%w: mac address", ErrMismatchPattern)
+	ErrInvalidRune     = fmt.Errorf("%w: character", ErrMismatchPattern)
+
+	ErrInvalidPostalCode      = fmt.Errorf("%w: postal code", ErrMismatchPattern)
+	ErrInvalidPhoneNumber     = fmt.Errorf("%w: phone number", ErrMismatchPattern)
+	ErrInvalidMyNumber        = fmt.Errorf("%w: my number", ErrMismatchPattern)
+	ErrInvalidCorporateNumber = fmt.Errorf("%w: corporate number", ErrMismatchPattern)
+	// ErrInvalidChecksum is returned when input has a valid format, but its check digit is wrong.
+	ErrInvalidChecksum = fmt.Errorf("%w: checksum", ErrMismatchPattern)
+	// ErrInvalidStructure is returned when input has unexpected characters or prefix.
+	ErrInvalidStructure = fmt.Errorf("%w: structure", ErrMismatchPattern)
+	// ErrInvalidLength is returned when input has unexpected number of characters.
+	ErrInvalidLength = fmt.Errorf("%w: length", ErrMismatchPattern)
+
+	ErrInvalidLuhn       = fmt.Errorf("%w: luhn number", ErrMismatchPattern)
+	ErrInvalidCreditCard = fmt.Errorf("%w: credit card number", ErrMismatchPattern)
+	ErrInvalidISBN       = fmt.Errorf("%w: isbn", ErrMismatchPattern)
+	ErrInvalidEAN        = fmt.Errorf("%w: ean", ErrMismatchPattern)
+	ErrInvalidIBAN       = fmt.Errorf("%w: iban", ErrMismatchPattern)
+
+	ErrInvalidDecimal = fmt.Errorf("%w: decimal", ErrMismatchPattern)
+	ErrNotCanonical   = fmt.Errorf("%w: canonical form", ErrMismatchPattern)
+
+	ErrInvalidTime      = fmt.Errorf("%w: time", ErrMismatchPattern)
+	ErrInvalidDuration  = fmt.Errorf("%w: duration", ErrMismatchPattern)
+	ErrInvalidTimeZone  = fmt.Errorf("%w: time zone", ErrMismatchPattern)
+	ErrInvalidDate      = fmt.Errorf("%w: date", ErrMismatchPattern)
+	ErrInvalidTimeOfDay = fmt.Errorf("%w: time of day", ErrMismatchPattern)
+)
+
+// Conversion errors wrap ErrInvalidType.
+var (
+	ErrNumberOverflow = fmt.Errorf("%w: number overflows", ErrInvalidType)
+	ErrFractional     = fmt.Errorf("%w: number has fractional part", ErrInvalidType)
+)
+
+// Document limit errors wrap ErrTooBig.
+var (
+	ErrTooDeep         = fmt.Errorf("%w: document is nested too deeply", ErrTooBig)
+	ErrTooManyElements = fmt.Errorf("%w: document has too many elements", ErrTooBig)
 )
 
 // ErrValidate is returned on validation error.
@@ -35,6 +105,81 @@ func (e *ErrValidate) Unwrap() error {
 	return e.Err
 }
 
+// ErrRune is returned when input contains a character which is not allowed.
+type ErrRune struct {
+	Rune rune
+	// Position is the index of Rune counted in characters.
+	Position int
+}
+
+func (e *ErrRune) Error() string {
+	return fmt.Sprintf("%v %q at %d", ErrInvalidRune, e.Rune, e.Position)
+}
+
+func (e *ErrRune) Unwrap() error {
+	return ErrInvalidRune
+}
+
+// ErrIdentifier is returned by checksum-based identifier rules.
+// It matches both Kind such as ErrInvalidIBAN and Reason
+// which is one of ErrInvalidStructure, ErrInvalidLength or ErrInvalidChecksum.
+type ErrIdentifier struct {
+	Kind   error
+	Reason error
+}
+
+func (e *ErrIdentifier) Error() string {
+	return fmt.Sprintf("%v: invalid %s", e.Kind, strings.TrimPrefix(e.Reason.Error(), ErrMismatchPattern.Error()+": "))
+}
+
+func (e *ErrIdentifier) Unwrap() []error {
+	return []error{e.Kind, e.Reason}
+}
+
+// ErrBound is returned when input exceeds a bound.
+// Err is ErrTooBig or ErrTooSmall.
+type ErrBound struct {
+	Err       error
+	Bound     string
+	Inclusive bool
+}
+
+func (e *ErrBound) Error() string {
+	op := ">"
+	if errors.Is(e.Err, ErrTooBig) {
+		op = "<"
+	}
+	if e.Inclusive {
+		op += "="
+	}
+	return fmt.Sprintf("%v: must be %s %s", e.Err, op, e.Bound)
+}
+
+func (e *ErrBound) Unwrap() error {
+	return e.Err
+}
+
+// ErrLookup is returned when LookupFunc fails as a whole, such as on a database outage,
+// or the context is done while waiting for a lookup.
+// It aborts the validation instead of being reported as an error of the field.
+type ErrLookup struct {
+	Err error
+}
+
+func (e *ErrLookup) Error() string {
+	return "lookup failed: " + e.Err.Error()
+}
+
+func (e *ErrLookup) Unwrap() error {
+	return e.Err
+}
+
+// isAbort reports whether err aborts the validation.
+func isAbort(err error) bool {
+	_, ok := err.(*ErrLookup)
+	return ok
+}
+
 // ErrObject is returned on object validation error.
 type ErrObject []*ErrObjectField
 
@@ -42,6 +187,11 @@ type ErrObject []*ErrObjectField
 type ErrObjectField struct {
 	Field string
 	Err   error
+	// Pointer is the JSON Pointer of the field from the root document such as "/items/2/price".
+	// It is set by MapValidator and ArrayValidator.
+	Pointer string
+	// Severity is SeverityWarning for the fields of Result.Warnings.
+	Severity Severity
 }
 
 func newErrObject(errs ...*ErrObjectField) error {
@@ -66,19 +216,171 @@ func (e ErrObject) Error() string {
 	return str.String()
 }
 
+// Is reports whether every field of err is in e with a matching error, regardless of the order.
+// Nested ErrObject are matched in the same way.
 func (e ErrObject) Is(err error) bool {
 	errs, ok := err.(ErrObject)
 	if !ok {
 		return false
 	}
-	for i, errObj := range errs {
-		if len(e)-1 < i {
-			return false
-		}
-		indexErr := e[i]
-		if indexErr.Field != errObj.Field || !errors.Is(indexErr.Err, errObj.Err) {
+	for _, target := range errs {
+		if !e.hasField(target) {
 			return false
 		}
 	}
 	return true
 }
+
+func (e ErrObject) hasField(target *ErrObjectField) bool {
+	for _, f := range e {
+		if f.Field == target.Field && errors.Is(f.Err, target.Err) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the fields of e, so that errors.Is and errors.As see every field.
+func (e ErrObject) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, f := range e {
+		errs[i] = f
+	}
+	return errs
+}
+
+// Fields returns the path of every failing field in order, such as "Address.City" or "Items.2.Price".
+// Nested ErrObject are expanded, so only the leaf fields are returned.
+func (e ErrObject) Fields() []string {
+	var paths []string
+	seen := make(map[string]bool)
+	e.walk("", func(path string, _ error) {
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	})
+	return paths
+}
+
+// For returns the errors of the field at path.
+// path is a dot separated path such as "Address.City", or a JSON Pointer such as "/items/2/price".
+// For the path of a nested object, the ErrObject of it is returned.
+func (e ErrObject) For(path string) []error {
+	return e.find(splitPath(path))
+}
+
+// HasCode reports whether one of the errors of the field at path matches code by errors.Is.
+func (e ErrObject) HasCode(path string, code error) bool {
+	for _, err := range e.For(path) {
+		if errors.Is(err, code) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e ErrObject) walk(prefix string, fn func(path string, err error)) {
+	for _, f := range e {
+		path := f.Field
+		if prefix != "" {
+			path = prefix + "." + f.Field
+		}
+		err := unwrapValidate(f.Err)
+		if nested, ok := err.(ErrObject); ok {
+			nested.walk(path, fn)
+			continue
+		}
+		fn(path, err)
+	}
+}
+
+func (e ErrObject) find(segments []string) []error {
+	var errs []error
+	for _, f := range e {
+		if f.Field != segments[0] {
+			continue
+		}
+		err := unwrapValidate(f.Err)
+		if len(segments) == 1 {
+			errs = append(errs, err)
+		} else if nested, ok := err.(ErrObject); ok {
+			errs = append(errs, nested.find(segments[1:])...)
+		}
+	}
+	return errs
+}
+
+func splitPath(path string) []string {
+	if !strings.HasPrefix(path, "/") {
+		return strings.Split(path, ".")
+	}
+	segments := strings.Split(path[1:], "/")
+	for i, s := range segments {
+		segments[i] = pointerUnescaper.Replace(s)
+	}
+	return segments
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+func (e *ErrObjectField) Error() string {
+	return fmt.Sprintf("%s field: %v", e.Field, e.Err)
+}
+
+func (e *ErrObjectField) Unwrap() error {
+	return e.Err
+}
+
+// Merge combines errs into one ErrObject.
+// Fields of the same name whose errors are ErrObject are merged recursively,
+// and an error which is not ErrObject is added as a field with empty name.
+// nil errors are ignored, and if all errors are nil, Merge returns nil.
+func Merge(errs ...error) error {
+	var merged ErrObject
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		fields, ok := unwrapValidate(err).(ErrObject)
+		if !ok {
+			fields = ErrObject{newErrObjectField("", err)}
+		}
+		for _, f := range fields {
+			merged = merged.add(f)
+		}
+	}
+	return newErrObject(merged...)
+}
+
+func (e ErrObject) add(field *ErrObjectField) ErrObject {
+	nested, ok := unwrapValidate(field.Err).(ErrObject)
+	if !ok {
+		return append(e, field)
+	}
+	for i, f := range e {
+		if current, ok := unwrapValidate(f.Err).(ErrObject); ok && f.Field == field.Field {
+			merged := append(ErrObject(nil), current...)
+			for _, nf := range nested {
+				merged = merged.add(nf)
+			}
+			e[i] = &ErrObjectField{Field: f.Field, Err: merged, Pointer: f.Pointer, Severity: f.Severity}
+			return e
+		}
+	}
+	return append(e, field)
+}
+
+// Prefix returns err as the error of the field at path, which is dot separated such as "Billing.Address".
+// JSON Pointers of the fields in err are set from the new root. If err is nil, Prefix returns nil.
+func Prefix(path string, err error) error {
+	if err == nil {
+		return nil
+	}
+	segments := strings.Split(path, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		err = ErrObject{newErrObjectField(segments[i], err)}
+	}
+	setPointers(err, "")
+	return err
+}
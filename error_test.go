@@ -0,0 +1,99 @@
+package svalidator_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestErrObject_Query(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type User struct {
+		Name    string
+		Tags    []string
+		Address Address
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[User]{
+		"Name": svalidator.String().Required(),
+		"Tags": svalidator.Slice[string](svalidator.String().Max(2)),
+		"Address": svalidator.Object(svalidator.ValidatorMap[Address]{
+			"City": svalidator.String().Required(),
+			"Zip":  svalidator.String().Required(),
+		}),
+	})
+	err := v.Validate(User{Tags: []string{"a", "abc"}, Address: Address{Zip: "1"}})
+
+	var oerr svalidator.ErrObject
+	if !errors.As(err, &oerr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if got, want := oerr.Fields(), []string{"Name", "Tags.1", "Address.City"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if got := oerr.For("Address.City"); len(got) != 1 || !errors.Is(got[0], svalidator.ErrEmpty) {
+		t.Errorf("unexpected errors %v", got)
+	}
+	if got := oerr.For("/Address/City"); len(got) != 1 {
+		t.Errorf("unexpected errors %v", got)
+	}
+	if got := oerr.For("Address"); len(got) != 1 || !errors.Is(got[0], svalidator.ErrEmpty) {
+		t.Errorf("unexpected errors %v", got)
+	}
+	if got := oerr.For("Address.Zip"); len(got) != 0 {
+		t.Errorf("unexpected errors %v", got)
+	}
+	if !oerr.HasCode("Tags.1", svalidator.ErrTooBig) || oerr.HasCode("Tags.0", svalidator.ErrTooBig) || oerr.HasCode("Name", svalidator.ErrTooBig) {
+		t.Error("unexpected HasCode result")
+	}
+
+	var field *svalidator.ErrObjectField
+	if !errors.As(err, &field) || field.Field != "Name" {
+		t.Errorf("unexpected field %v", field)
+	}
+	if !errors.Is(err, svalidator.ErrTooBig) || errors.Is(err, svalidator.ErrNotFound) {
+		t.Error("errors.Is does not see fields")
+	}
+}
+
+func TestMergePrefix(t *testing.T) {
+	billing := svalidator.Object(svalidator.ValidatorMap[struct{ City, Zip string }]{
+		"City": svalidator.String().Required(),
+		"Zip":  svalidator.String().Required(),
+	})
+	err := svalidator.Merge(
+		svalidator.Prefix("Billing.Address", billing.Validate(struct{ City, Zip string }{Zip: "1"})),
+		nil,
+		svalidator.Prefix("Billing.Address", billing.Validate(struct{ City, Zip string }{City: "Tokyo"})),
+		svalidator.Prefix("Terms", svalidator.ErrEmpty),
+	)
+	var oerr svalidator.ErrObject
+	if !errors.As(err, &oerr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if got, want := oerr.Fields(), []string{"Billing.Address.City", "Billing.Address.Zip", "Terms"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	assertError(t, svalidator.ErrObject{
+		&svalidator.ErrObjectField{Field: "Billing", Err: svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "Address", Err: svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "Zip", Err: svalidator.ErrEmpty},
+			}},
+		}},
+	}, err)
+	var field *svalidator.ErrObjectField
+	if !errors.As(oerr.For("Billing.Address")[0], &field) || field.Pointer != "/Billing/Address/City" {
+		t.Errorf("unexpected field %+v", field)
+	}
+
+	if svalidator.Merge(nil, nil) != nil {
+		t.Error("merge of nil is not nil")
+	}
+	if got := svalidator.Merge(svalidator.ErrEmpty); !got.(svalidator.ErrObject).HasCode("", svalidator.ErrEmpty) {
+		t.Errorf("unexpected error %v", got)
+	}
+}
@@ -160,3 +160,17 @@ func ExampleErrObject() {
 	// ID field is error
 	// Name field is error
 }
+
+func ExampleOptional() {
+	validator := svalidator.Optional[string](svalidator.String().Max(3))
+
+	err := validator.Validate(nil)
+	fmt.Printf("err is %t\n", err != nil)
+
+	err = validator.Validate(&[]string{"hello"}[0])
+	fmt.Printf("err is %t\n", err != nil)
+
+	// Output:
+	// err is false
+	// err is true
+}
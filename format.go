@@ -0,0 +1,289 @@
+package svalidator
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// Email adds a validate whether input is a bare email address such as "user@example.com".
+// Display names and IP literal domains are rejected.
+func (s *UStringValidator[T]) Email() *UStringValidator[T] {
+	return s.format(isEmail, ErrInvalidEmail)
+}
+
+// URL adds a validate whether input is an absolute URL with a host.
+// If schemes is specified, the scheme of input must be one of them.
+func (s *UStringValidator[T]) URL(schemes ...string) *UStringValidator[T] {
+	return s.format(func(str string) bool { return isURL(str, schemes) }, ErrInvalidURL)
+}
+
+// UUID adds a validate whether input is a hyphenated UUID of version.
+// Zero version accepts any version and variant.
+// This panics if version is not zero and out of 1 to 8.
+func (s *UStringValidator[T]) UUID(version int) *UStringValidator[T] {
+	checkUUIDVersion(version)
+	return s.format(func(str string) bool { return isUUID(str, version) }, ErrInvalidUUID)
+}
+
+// ULID adds a validate whether input is a ULID.
+func (s *UStringValidator[T]) ULID() *UStringValidator[T] {
+	return s.format(isULID, ErrInvalidULID)
+}
+
+// Hostname adds a validate whether input is a hostname defined by RFC 1123.
+func (s *UStringValidator[T]) Hostname() *UStringValidator[T] {
+	return s.format(isHostname, ErrInvalidHostname)
+}
+
+// IP adds a validate whether input is an IPv4 or IPv6 address.
+func (s *UStringValidator[T]) IP() *UStringValidator[T] {
+	return s.format(isIP, ErrInvalidIP)
+}
+
+// IPv4 adds a validate whether input is an IPv4 address.
+func (s *UStringValidator[T]) IPv4() *UStringValidator[T] {
+	return s.format(isIPv4, ErrInvalidIPv4)
+}
+
+// IPv6 adds a validate whether input is an IPv6 address.
+func (s *UStringValidator[T]) IPv6() *UStringValidator[T] {
+	return s.format(isIPv6, ErrInvalidIPv6)
+}
+
+// CIDR adds a validate whether input is an IP address prefix such as "192.168.0.0/16".
+func (s *UStringValidator[T]) CIDR() *UStringValidator[T] {
+	return s.format(isCIDR, ErrInvalidCIDR)
+}
+
+// MAC adds a validate whether input is a MAC address.
+func (s *UStringValidator[T]) MAC() *UStringValidator[T] {
+	return s.format(isMAC, ErrInvalidMAC)
+}
+
+func (s *UStringValidator[T]) format(valid func(string) bool, err error) *UStringValidator[T] {
+	return s.check(func(str string) error {
+		if !valid(str) {
+			return err
+		}
+		return nil
+	})
+}
+
+func (s *UStringValidator[T]) check(check func(string) error) *UStringValidator[T] {
+	return s.AppendValidate(func(value T) error {
+		return check(string(value))
+	})
+}
+
+// Email adds a validate whether input is a bare email address.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) Email() *PointerUStringValidator[T] {
+	return s.format(isEmail, ErrInvalidEmail)
+}
+
+// URL adds a validate whether input is an absolute URL with a host.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) URL(schemes ...string) *PointerUStringValidator[T] {
+	return s.format(func(str string) bool { return isURL(str, schemes) }, ErrInvalidURL)
+}
+
+// UUID adds a validate whether input is a hyphenated UUID of version.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) UUID(version int) *PointerUStringValidator[T] {
+	checkUUIDVersion(version)
+	return s.format(func(str string) bool { return isUUID(str, version) }, ErrInvalidUUID)
+}
+
+// ULID adds a validate whether input is a ULID.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) ULID() *PointerUStringValidator[T] {
+	return s.format(isULID, ErrInvalidULID)
+}
+
+// Hostname adds a validate whether input is a hostname defined by RFC 1123.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) Hostname() *PointerUStringValidator[T] {
+	return s.format(isHostname, ErrInvalidHostname)
+}
+
+// IP adds a validate whether input is an IPv4 or IPv6 address.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) IP() *PointerUStringValidator[T] {
+	return s.format(isIP, ErrInvalidIP)
+}
+
+// IPv4 adds a validate whether input is an IPv4 address.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) IPv4() *PointerUStringValidator[T] {
+	return s.format(isIPv4, ErrInvalidIPv4)
+}
+
+// IPv6 adds a validate whether input is an IPv6 address.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) IPv6() *PointerUStringValidator[T] {
+	return s.format(isIPv6, ErrInvalidIPv6)
+}
+
+// CIDR adds a validate whether input is an IP address prefix.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) CIDR() *PointerUStringValidator[T] {
+	return s.format(isCIDR, ErrInvalidCIDR)
+}
+
+// MAC adds a validate whether input is a MAC address.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MAC() *PointerUStringValidator[T] {
+	return s.format(isMAC, ErrInvalidMAC)
+}
+
+func (s *PointerUStringValidator[T]) format(valid func(string) bool, err error) *PointerUStringValidator[T] {
+	return s.check(func(str string) error {
+		if !valid(str) {
+			return err
+		}
+		return nil
+	})
+}
+
+func (s *PointerUStringValidator[T]) check(check func(string) error) *PointerUStringValidator[T] {
+	return s.AppendValidate(func(value *T) error {
+		if value == nil {
+			return nil
+		}
+		return check(string(*value))
+	})
+}
+
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Name != "" || addr.Address != s {
+		return false
+	}
+	at := strings.LastIndexByte(s, '@')
+	return at > 0 && len(s[:at]) <= 64 && isHostname(s[at+1:])
+}
+
+func isURL(s string, schemes []string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" || u.Opaque != "" {
+		return false
+	}
+	if len(schemes) == 0 {
+		return true
+	}
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkUUIDVersion(version int) {
+	if version < 0 || version > 8 {
+		panic(fmt.Sprintf("uuid version %d is not in 1 to 8", version))
+	}
+}
+
+func isUUID(s string, version int) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHex(s[i]) {
+				return false
+			}
+		}
+	}
+	if version == 0 {
+		return true
+	}
+	return s[14] == hexDigits[version] && strings.IndexByte("89abAB", s[19]) >= 0
+}
+
+const hexDigits = "0123456789abcdef"
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// crockford is the alphabet of Crockford's Base32 used by ULID.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+func isULID(s string) bool {
+	if len(s) != 26 {
+		return false
+	}
+	// the first character holds only 3 bits of the 48 bits timestamp.
+	if s[0] > '7' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if strings.IndexByte(crockford, c) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isHostname(s string) bool {
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func parseAddr(s string) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(s)
+	return addr, err == nil && addr.Zone() == ""
+}
+
+func isIP(s string) bool {
+	_, ok := parseAddr(s)
+	return ok
+}
+
+func isIPv4(s string) bool {
+	addr, ok := parseAddr(s)
+	return ok && addr.Is4()
+}
+
+func isIPv6(s string) bool {
+	addr, ok := parseAddr(s)
+	return ok && addr.Is6()
+}
+
+func isCIDR(s string) bool {
+	_, err := netip.ParsePrefix(s)
+	return err == nil
+}
+
+func isMAC(s string) bool {
+	_, err := net.ParseMAC(s)
+	return err == nil
+}
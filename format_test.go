@@ -0,0 +1,105 @@
+package svalidator_test
+
+import (
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestUString_Format(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.StringValidator
+			input     string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"email", args{svalidator.String().Email(), "user.name+tag@example.co.jp"}, nil},
+		{"email with name", args{svalidator.String().Email(), "User <user@example.com>"}, svalidator.ErrInvalidEmail},
+		{"email without domain", args{svalidator.String().Email(), "user@"}, svalidator.ErrInvalidEmail},
+		{"email with ip literal", args{svalidator.String().Email(), "user@[127.0.0.1]"}, svalidator.ErrInvalidEmail},
+		{"url", args{svalidator.String().URL(), "https://example.com/path?q=1"}, nil},
+		{"url without host", args{svalidator.String().URL(), "/path"}, svalidator.ErrInvalidURL},
+		{"url scheme", args{svalidator.String().URL("https"), "HTTPS://example.com"}, nil},
+		{"url scheme error", args{svalidator.String().URL("https"), "ftp://example.com"}, svalidator.ErrInvalidURL},
+		{"uuid", args{svalidator.String().UUID(4), "f47ac10b-58cc-4372-a567-0e02b2c3d479"}, nil},
+		{"uuid any version", args{svalidator.String().UUID(0), "00000000-0000-0000-0000-000000000000"}, nil},
+		{"uuid version 8", args{svalidator.String().UUID(8), "f47ac10b-58cc-8372-a567-0e02b2c3d479"}, nil},
+		{"uuid version error", args{svalidator.String().UUID(7), "f47ac10b-58cc-4372-a567-0e02b2c3d479"}, svalidator.ErrInvalidUUID},
+		{"uuid variant error", args{svalidator.String().UUID(4), "f47ac10b-58cc-4372-c567-0e02b2c3d479"}, svalidator.ErrInvalidUUID},
+		{"uuid format error", args{svalidator.String().UUID(0), "f47ac10b58cc4372a5670e02b2c3d479"}, svalidator.ErrInvalidUUID},
+		{"ulid", args{svalidator.String().ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FAV"}, nil},
+		{"ulid overflow", args{svalidator.String().ULID(), "81ARZ3NDEKTSV4RRFFQ69G5FAV"}, svalidator.ErrInvalidULID},
+		{"ulid invalid character", args{svalidator.String().ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FAU"}, svalidator.ErrInvalidULID},
+		{"hostname", args{svalidator.String().Hostname(), "api-1.example.com"}, nil},
+		{"hostname hyphen", args{svalidator.String().Hostname(), "-api.example.com"}, svalidator.ErrInvalidHostname},
+		{"hostname empty label", args{svalidator.String().Hostname(), "api..example.com"}, svalidator.ErrInvalidHostname},
+		{"ip", args{svalidator.String().IP(), "::1"}, nil},
+		{"ip error", args{svalidator.String().IP(), "256.0.0.1"}, svalidator.ErrInvalidIP},
+		{"ipv4", args{svalidator.String().IPv4(), "192.168.0.1"}, nil},
+		{"ipv4 leading zero", args{svalidator.String().IPv4(), "192.168.0.01"}, svalidator.ErrInvalidIPv4},
+		{"ipv4 error", args{svalidator.String().IPv4(), "::1"}, svalidator.ErrInvalidIPv4},
+		{"ipv6", args{svalidator.String().IPv6(), "2001:db8::1"}, nil},
+		{"ipv6 zone", args{svalidator.String().IPv6(), "fe80::1%eth0"}, svalidator.ErrInvalidIPv6},
+		{"cidr", args{svalidator.String().CIDR(), "10.0.0.0/8"}, nil},
+		{"cidr error", args{svalidator.String().CIDR(), "10.0.0.0"}, svalidator.ErrInvalidCIDR},
+		{"mac", args{svalidator.String().MAC(), "00:00:5e:00:53:01"}, nil},
+		{"mac error", args{svalidator.String().MAC(), "00:00:5e:00:53"}, svalidator.ErrInvalidMAC},
+		{"mismatch pattern", args{svalidator.String().MAC(), "bad"}, svalidator.ErrMismatchPattern},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
+
+func TestPointerUString_Format(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.PointerStringValidator
+			input     *string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"nil", args{svalidator.PointerString().Email().URL().UUID(4).ULID().Hostname().IP().IPv4().IPv6().CIDR().MAC(), nil}, nil},
+		{"email", args{svalidator.PointerString().Email(), pointer("user@example.com")}, nil},
+		{"email error", args{svalidator.PointerString().Email(), pointer("user")}, svalidator.ErrInvalidEmail},
+		{"uuid error", args{svalidator.PointerString().UUID(4), pointer("bad")}, svalidator.ErrInvalidUUID},
+		{"ipv4 error", args{svalidator.PointerString().IPv4(), pointer("::1")}, svalidator.ErrInvalidIPv4},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
+
+func TestUString_InvalidUUIDVersion(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		uuid func()
+	}{
+		{"negative", func() { svalidator.String().UUID(-1) }},
+		{"too large", func() { svalidator.String().UUID(9) }},
+		{"masked to valid", func() { svalidator.String().UUID(0x14) }},
+		{"pointer", func() { svalidator.PointerString().UUID(16) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("want panic")
+				}
+			}()
+			tt.uuid()
+		})
+	}
+}
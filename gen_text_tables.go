@@ -0,0 +1,224 @@
+//go:build ignore
+
+// gen_text_tables generates text_tables.go from the Unicode Character Database,
+// and copies GraphemeBreakTest.txt to testdata for the test of grapheme clusters.
+//
+// Usage:
+//
+//	go run gen_text_tables.go [-version 14.0.0] [-dir path]
+//
+// The files are downloaded from unicode.org unless -dir is given.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	version = flag.String("version", "14.0.0", "Unicode version")
+	dir     = flag.String("dir", "", "directory which has the UCD files instead of downloading them")
+)
+
+// source files relative to the ucd directory of the version.
+const (
+	graphemeBreakProperty = "auxiliary/GraphemeBreakProperty.txt"
+	graphemeBreakTest     = "auxiliary/GraphemeBreakTest.txt"
+	emojiData             = "emoji/emoji-data.txt"
+	eastAsianWidth        = "EastAsianWidth.txt"
+)
+
+// graphemeProps maps Grapheme_Cluster_Break values and Extended_Pictographic to the constants of text.go.
+var graphemeProps = map[string]string{
+	"Prepend":               "gpPrepend",
+	"CR":                    "gpCR",
+	"LF":                    "gpLF",
+	"Control":               "gpControl",
+	"Extend":                "gpExtend",
+	"ZWJ":                   "gpZWJ",
+	"Regional_Indicator":    "gpRegionalIndicator",
+	"SpacingMark":           "gpSpacingMark",
+	"L":                     "gpL",
+	"V":                     "gpV",
+	"T":                     "gpT",
+	"LV":                    "gpLV",
+	"LVT":                   "gpLVT",
+	"Extended_Pictographic": "gpExtendedPictographic",
+}
+
+type codeRange struct {
+	lo, hi rune
+	value  string
+}
+
+func main() {
+	flag.Parse()
+	log.SetFlags(0)
+
+	grapheme := parse(graphemeBreakProperty, func(v string) bool { return graphemeProps[v] != "" })
+	grapheme = append(grapheme, parse(emojiData, func(v string) bool { return v == "Extended_Pictographic" })...)
+	wide := parse(eastAsianWidth, func(v string) bool { return v == "W" || v == "F" })
+	for i := range wide {
+		wide[i].value = ""
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_text_tables.go from Unicode %s; DO NOT EDIT.\n\n", *version)
+	buf.WriteString("package svalidator\n\nimport \"unicode\"\n\n")
+	buf.WriteString("// graphemeRanges lists Grapheme_Cluster_Break and Extended_Pictographic properties in code point order.\n")
+	buf.WriteString("// Code points which are not listed are Other.\n")
+	buf.WriteString("var graphemeRanges = []graphemeRange{\n")
+	for _, r := range merge(grapheme) {
+		fmt.Fprintf(&buf, "\t{0x%04x, 0x%04x, %s},\n", r.lo, r.hi, graphemeProps[r.value])
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// eastAsianWideTable lists East Asian Width W and F characters.\n")
+	buf.WriteString("var eastAsianWideTable = &unicode.RangeTable{\n")
+	var r16, r32 []codeRange
+	for _, r := range merge(wide) {
+		switch {
+		case r.hi <= 0xffff:
+			r16 = append(r16, r)
+		case r.lo > 0xffff:
+			r32 = append(r32, r)
+		default:
+			r16 = append(r16, codeRange{lo: r.lo, hi: 0xffff})
+			r32 = append(r32, codeRange{lo: 0x10000, hi: r.hi})
+		}
+	}
+	writeRanges(&buf, "R16", "Range16", r16)
+	writeRanges(&buf, "R32", "Range32", r32)
+	fmt.Fprintf(&buf, "\tLatinOffset: %d,\n", latinOffset(r16))
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("text_tables.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	test, err := open(graphemeBreakTest)
+	if err != nil {
+		log.Printf("skip %s: %v", graphemeBreakTest, err)
+		return
+	}
+	defer test.Close()
+	out, err := os.Create(filepath.Join("testdata", "GraphemeBreakTest.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, test); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// open opens name in the directory of -dir, or downloads it.
+func open(name string) (io.ReadCloser, error) {
+	if *dir != "" {
+		return os.Open(filepath.Join(*dir, filepath.Base(name)))
+	}
+	url := "https://www.unicode.org/Public/" + *version + "/ucd/" + name
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// parse returns ranges of name whose property value is accepted by keep.
+// Each line of the file is "code or lo..hi ; value # comment".
+func parse(name string, keep func(value string) bool) []codeRange {
+	f, err := open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var ranges []codeRange
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		codes, value, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if !keep(value) {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(strings.TrimSpace(codes), "..")
+		if !isRange {
+			hi = lo
+		}
+		ranges = append(ranges, codeRange{lo: hex(lo), hi: hex(hi), value: value})
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return ranges
+}
+
+func hex(s string) rune {
+	n, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(n)
+}
+
+// merge sorts ranges and joins adjacent ranges of the same value.
+func merge(ranges []codeRange) []codeRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	var merged []codeRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if r.lo <= last.hi {
+				log.Fatalf("%04X..%04X overlaps %04X..%04X", r.lo, r.hi, last.lo, last.hi)
+			}
+			if last.hi+1 == r.lo && last.value == r.value {
+				last.hi = r.hi
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+func writeRanges(buf *bytes.Buffer, field, typ string, ranges []codeRange) {
+	fmt.Fprintf(buf, "\t%s: []unicode.%s{\n", field, typ)
+	for _, r := range ranges {
+		fmt.Fprintf(buf, "\t\t{Lo: 0x%04x, Hi: 0x%04x, Stride: 1},\n", r.lo, r.hi)
+	}
+	buf.WriteString("\t},\n")
+}
+
+func latinOffset(r16 []codeRange) int {
+	var n int
+	for _, r := range r16 {
+		if r.hi > 0xff {
+			break
+		}
+		n++
+	}
+	return n
+}
@@ -0,0 +1,274 @@
+package svalidator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Group is a name of validation group, such as "create" and "update".
+// A group must be declared by Groups.Declare before use.
+type Group string
+
+// Groups is a set of declared validation groups.
+// Pass it to SafeObject or Object which uses the groups.
+type Groups struct {
+	mu      sync.RWMutex
+	parents map[Group][]Group
+}
+
+// NewGroups returns Groups which has no group.
+func NewGroups() *Groups {
+	return &Groups{parents: map[Group][]Group{}}
+}
+
+// Declare declares the validation group name.
+// Validating with the group also runs the rules of parents and their ancestors.
+// Declaring the same name again with the same parents returns the group.
+// This panics if name is empty or already declared with other parents, or a parent is not declared.
+func (g *Groups) Declare(name string, parents ...Group) Group {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	group := Group(name)
+	if name == "" {
+		panic("group name is empty")
+	}
+	for _, p := range parents {
+		if _, exists := g.parents[p]; !exists {
+			panic(fmt.Sprintf("parent group %s of %s is not declared", p, name))
+		}
+	}
+	if declared, exists := g.parents[group]; exists {
+		if !sameGroups(declared, parents) {
+			panic(fmt.Sprintf("group %s is already declared with parents %v", name, declared))
+		}
+		return group
+	}
+	g.parents[group] = append([]Group{}, parents...)
+	return group
+}
+
+func (g *Groups) declared(group Group) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	_, exists := g.parents[group]
+	return exists
+}
+
+func (g *Groups) parentsOf(group Group) []Group {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.parents[group]
+}
+
+// sameGroups reports whether a and b have the same groups ignoring order and duplicates.
+func sameGroups(a, b []Group) bool {
+	setA, setB := groupSetOf(a), groupSetOf(b)
+	if len(setA) != len(setB) {
+		return false
+	}
+	for g := range setA {
+		if !setB[g] {
+			return false
+		}
+	}
+	return true
+}
+
+func groupSetOf(groups []Group) map[Group]bool {
+	set := make(map[Group]bool, len(groups))
+	for _, g := range groups {
+		set[g] = true
+	}
+	return set
+}
+
+// groupSet is the Groups passed to SafeObject.
+type groupSet []*Groups
+
+// check returns ErrUndeclaredGroup if one of groups is not declared in s.
+func (s groupSet) check(groups []Group) error {
+	for _, g := range groups {
+		if !s.declared(g) {
+			return fmt.Errorf("%w: %s", ErrUndeclaredGroup, g)
+		}
+	}
+	return nil
+}
+
+func (s groupSet) declared(group Group) bool {
+	for _, g := range s {
+		if g.declared(group) {
+			return true
+		}
+	}
+	return false
+}
+
+// active returns groups and their ancestors.
+func (s groupSet) active(groups []Group) map[Group]bool {
+	active := map[Group]bool{}
+	for len(groups) > 0 {
+		group := groups[len(groups)-1]
+		groups = groups[:len(groups)-1]
+		if active[group] {
+			continue
+		}
+		active[group] = true
+		for _, g := range s {
+			groups = append(groups, g.parentsOf(group)...)
+		}
+	}
+	return active
+}
+
+func (s groupSet) same(other groupSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i := range s {
+		if s[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type groupKey struct{}
+
+// groupScope is the groups requested for a validation, and the active groups resolved
+// with the Groups of the innermost ObjectValidator which has Groups.
+type groupScope struct {
+	requested []Group
+	set       groupSet
+	active    map[Group]bool
+}
+
+// WithGroups returns ctx which validates ObjectValidator with groups.
+// Use it to combine groups with other validations, such as ValidatePartialContext.
+// The outermost ObjectValidator returns ErrUndeclaredGroup if one of groups is not declared in its Groups.
+func WithGroups(ctx context.Context, groups ...Group) context.Context {
+	return context.WithValue(ctx, groupKey{}, &groupScope{requested: groups})
+}
+
+// enter resolves the active groups of ctx with s, because ancestors of a group depend on the Groups.
+// An ObjectValidator without Groups uses the active groups of the parent.
+func (s groupSet) enter(ctx context.Context) (context.Context, error) {
+	scope, _ := ctx.Value(groupKey{}).(*groupScope)
+	if scope == nil {
+		return ctx, nil
+	}
+	if scope.active == nil {
+		if err := s.check(scope.requested); err != nil {
+			return ctx, err
+		}
+	} else if len(s) == 0 || s.same(scope.set) {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, groupKey{}, &groupScope{
+		requested: scope.requested,
+		set:       s,
+		active:    s.active(scope.requested),
+	}), nil
+}
+
+// inGroups reports whether one of groups is active in ctx.
+func inGroups(ctx context.Context, groups []Group) bool {
+	scope, _ := ctx.Value(groupKey{}).(*groupScope)
+	if scope == nil {
+		return false
+	}
+	for _, g := range groups {
+		if scope.active[g] {
+			return true
+		}
+	}
+	return false
+}
+
+// InGroup returns a validator which runs inner only when one of groups is active.
+// Use it as an entry of ValidatorMap, or as a rule with AppendValidator.
+func InGroup[T any](inner ValueValidator[T], groups ...Group) *Validator[T] {
+	return groupValidator(inner, groups, true)
+}
+
+// ExceptGroup returns a validator which runs inner unless one of groups is active.
+func ExceptGroup[T any](inner ValueValidator[T], groups ...Group) *Validator[T] {
+	return groupValidator(inner, groups, false)
+}
+
+func groupValidator[T any](inner ValueValidator[T], groups []Group, in bool) *Validator[T] {
+	v := NewContext(func(ctx context.Context, value T) error {
+		if inGroups(ctx, groups) != in {
+			return nil
+		}
+		return unwrapValidate(validateWith(ctx, inner, value))
+	})
+	v.groups = append(append(v.groups, groups...), groupsOf(inner)...)
+	return v
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (v *Validator[T]) AppendValidator(inner ValueValidator[T]) *Validator[T] {
+	v.groups = append(v.groups, groupsOf(inner)...)
+	return v.AppendContextValidate(func(ctx context.Context, value T) error {
+		return unwrapValidate(validateWith(ctx, inner, value))
+	})
+}
+
+func (v *Validator[T]) usedGroups() []Group {
+	return v.groups
+}
+
+// groupsOf returns the groups used by the rules of validator.
+func groupsOf(validator any) []Group {
+	if g, ok := validator.(interface{ usedGroups() []Group }); ok {
+		return g.usedGroups()
+	}
+	return nil
+}
+
+func (o *OptionalValidator[T]) usedGroups() []Group {
+	groups := o.Validator.usedGroups()
+	return append(groups[:len(groups):len(groups)], groupsOf(o.inner)...)
+}
+
+func (s *SliceValidator[T]) usedGroups() []Group {
+	groups := s.Validator.usedGroups()
+	return append(groups[:len(groups):len(groups)], groupsOf(s.inner)...)
+}
+
+func (n *NullValidator[N, T]) usedGroups() []Group {
+	groups := n.Validator.usedGroups()
+	return append(groups[:len(groups):len(groups)], groupsOf(n.inner)...)
+}
+
+func (a *ArrayValidator) usedGroups() []Group {
+	groups := a.Validator.usedGroups()
+	return append(groups[:len(groups):len(groups)], groupsOf(a.inner)...)
+}
+
+func (m *MapValidator) usedGroups() []Group {
+	groups := m.Validator.usedGroups()
+	groups = groups[:len(groups):len(groups)]
+	for _, validator := range m.object {
+		validator, _ := keyPolicy(validator)
+		groups = append(groups, groupsOf(validator)...)
+	}
+	return groups
+}
+
+// ValidateGroup validates value with groups, which must be declared in the Groups passed to SafeObject.
+// Rules of InGroup run only when one of groups or their ancestors is active, and rules without group always run.
+// A nested ObjectValidator resolves the ancestors with its own Groups.
+func (o *ObjectValidator[T]) ValidateGroup(value T, groups ...Group) error {
+	return o.ValidateGroupContext(context.Background(), value, groups...)
+}
+
+// ValidateGroupContext is ValidateGroup with ctx.
+func (o *ObjectValidator[T]) ValidateGroupContext(ctx context.Context, value T, groups ...Group) error {
+	if err := o.groups.check(groups); err != nil {
+		return err
+	}
+	return o.ValidateContext(WithGroups(ctx, groups...), value)
+}
@@ -0,0 +1,281 @@
+package svalidator_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+var (
+	testGroups  = svalidator.NewGroups()
+	groupCreate = testGroups.Declare("create")
+	groupUpdate = testGroups.Declare("update")
+	groupAdmin  = testGroups.Declare("admin", groupUpdate)
+)
+
+type groupUser struct {
+	ID   string
+	Name string
+	Tags []string
+}
+
+func emptyString(value string) error {
+	if value != "" {
+		return svalidator.ErrNotEmpty
+	}
+	return nil
+}
+
+func groupUserValidator() *svalidator.ObjectValidator[groupUser] {
+	return svalidator.Object(svalidator.ValidatorMap[groupUser]{
+		"ID": svalidator.String().AppendValidator(svalidator.InGroup[string](svalidator.New(emptyString), groupCreate)).
+			AppendValidator(svalidator.InGroup[string](svalidator.String().Required(), groupUpdate)),
+		"Name": svalidator.String().Required().
+			AppendValidator(svalidator.ExceptGroup[string](svalidator.String().Max(5), groupAdmin)),
+		"Tags": svalidator.InGroup[[]string](svalidator.Slice[string](svalidator.String().Required()), groupCreate),
+	}, testGroups)
+}
+
+func TestObject_ValidateGroup(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		input  groupUser
+		groups []svalidator.Group
+		want   error
+	}{
+		{"no group", groupUser{ID: "1", Name: "name"}, nil, nil},
+		{"no group runs except rule", groupUser{Name: "too long"}, nil, svalidator.ErrObject{
+			{Field: "Name", Err: svalidator.ErrTooBig},
+		}},
+		{"create", groupUser{Name: "name"}, []svalidator.Group{groupCreate}, nil},
+		{"create with id", groupUser{ID: "1", Name: "name", Tags: []string{""}}, []svalidator.Group{groupCreate}, svalidator.ErrObject{
+			{Field: "ID", Err: svalidator.ErrNotEmpty},
+			{Field: "Tags", Err: svalidator.ErrObject{{Field: "0", Err: svalidator.ErrEmpty}}},
+		}},
+		{"update without id", groupUser{Name: "name", Tags: []string{""}}, []svalidator.Group{groupUpdate}, svalidator.ErrObject{
+			{Field: "ID", Err: svalidator.ErrEmpty},
+		}},
+		{"admin inherits update", groupUser{Name: "too long"}, []svalidator.Group{groupAdmin}, svalidator.ErrObject{
+			{Field: "ID", Err: svalidator.ErrEmpty},
+		}},
+		{"admin bypasses limit", groupUser{ID: "1", Name: "too long"}, []svalidator.Group{groupAdmin}, nil},
+		{"string group", groupUser{Name: "name"}, []svalidator.Group{"update"}, svalidator.ErrObject{
+			{Field: "ID", Err: svalidator.ErrEmpty},
+		}},
+		{"undeclared group", groupUser{}, []svalidator.Group{"unknown"}, svalidator.ErrUndeclaredGroup},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := groupUserValidator().ValidateGroup(tt.input, tt.groups...)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestObject_ValidateGroupNested(t *testing.T) {
+	type Parent struct {
+		User  groupUser
+		Users []groupUser
+		Ptr   *groupUser
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[Parent]{
+		"User":  groupUserValidator(),
+		"Users": svalidator.Slice[groupUser](groupUserValidator()),
+		"Ptr":   svalidator.Optional[groupUser](groupUserValidator()),
+	}, testGroups)
+	input := Parent{User: groupUser{Name: "a"}, Users: []groupUser{{Name: "a"}}, Ptr: &groupUser{Name: "a"}}
+	assertError(t, nil, v.Validate(input))
+	assertError(t, svalidator.ErrObject{
+		{Field: "User", Err: svalidator.ErrObject{{Field: "ID", Err: svalidator.ErrEmpty}}},
+		{Field: "Users", Err: svalidator.ErrObject{{Field: "0", Err: svalidator.ErrObject{{Field: "ID", Err: svalidator.ErrEmpty}}}}},
+		{Field: "Ptr", Err: svalidator.ErrObject{{Field: "ID", Err: svalidator.ErrEmpty}}},
+	}, v.ValidateGroup(input, groupUpdate))
+}
+
+func TestWithGroups(t *testing.T) {
+	ctx := svalidator.WithGroups(context.Background(), groupUpdate)
+	v := groupUserValidator()
+	assertError(t, svalidator.ErrObject{
+		{Field: "ID", Err: svalidator.ErrEmpty},
+	}, v.ValidatePartialContext(ctx, groupUser{Name: "toolong"}, svalidator.NewFieldMask("ID")))
+	assertError(t, svalidator.ErrObject{
+		{Field: "ID", Err: svalidator.ErrEmpty},
+		{Field: "Name", Err: svalidator.ErrTooBig},
+	}, v.ValidateContext(ctx, groupUser{Name: "toolong"}))
+	assertError(t, nil, v.ValidatePartialContext(svalidator.WithGroups(context.Background(), groupAdmin), groupUser{ID: "1", Name: "toolong"}, svalidator.NewFieldMask("Name")))
+
+	err := v.ValidatePartialContext(svalidator.WithGroups(context.Background(), "undeclared"), groupUser{}, svalidator.NewFieldMask("ID"))
+	if !errors.Is(err, svalidator.ErrUndeclaredGroup) {
+		t.Errorf("want ErrUndeclaredGroup, got %v", err)
+	}
+}
+
+func TestObject_ValidateGroupNestedGroups(t *testing.T) {
+	type Inner struct {
+		Name string
+		Note string
+	}
+	type Outer struct {
+		ID    string
+		Inner Inner
+	}
+	other := svalidator.NewGroups()
+	base := other.Declare("base")
+	other.Declare("update", base)
+	inner := svalidator.Object(svalidator.ValidatorMap[Inner]{
+		"Name": svalidator.InGroup[string](svalidator.String().Required(), base),
+		"Note": svalidator.InGroup[string](svalidator.String().Required(), groupUpdate),
+	}, other)
+	v := svalidator.Object(svalidator.ValidatorMap[Outer]{
+		"ID":    svalidator.InGroup[string](svalidator.String().Required(), groupUpdate),
+		"Inner": inner,
+	}, testGroups)
+
+	// ancestors of update are resolved with the Groups of each object.
+	assertError(t, svalidator.ErrObject{
+		{Field: "ID", Err: svalidator.ErrEmpty},
+		{Field: "Inner", Err: svalidator.ErrObject{
+			{Field: "Name", Err: svalidator.ErrEmpty},
+			{Field: "Note", Err: svalidator.ErrEmpty},
+		}},
+	}, v.ValidateGroup(Outer{}, groupUpdate))
+	// admin is a child of update only in testGroups.
+	assertError(t, svalidator.ErrObject{
+		{Field: "ID", Err: svalidator.ErrEmpty},
+	}, v.ValidateGroup(Outer{}, groupAdmin))
+}
+
+func TestAppendValidator_Chain(t *testing.T) {
+	type Item struct {
+		Name  string
+		Price int
+		Tags  []string
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[Item]{
+		"Name":  svalidator.String().AppendValidator(svalidator.InGroup[string](svalidator.String().Required(), groupCreate)).Max(3),
+		"Price": svalidator.Number[int]().AppendValidator(svalidator.InGroup[int](svalidator.Number[int]().NonZero(), groupCreate)).Min(0),
+		"Tags":  svalidator.Slice[string](svalidator.String()).AppendValidator(svalidator.InGroup[[]string](svalidator.Slice[string](svalidator.String()).Min(1), groupCreate)).Max(2),
+	}, testGroups).AppendValidator(svalidator.ExceptGroup[Item](svalidator.New(func(Item) error { return svalidator.ErrNotEqual }), groupCreate, groupUpdate))
+
+	assertError(t, svalidator.ErrObject{
+		{Field: "Name", Err: svalidator.ErrEmpty},
+		{Field: "Price", Err: svalidator.ErrZero},
+		{Field: "Tags", Err: svalidator.ErrTooSmall},
+	}, v.ValidateGroup(Item{}, groupCreate))
+	assertError(t, svalidator.ErrObject{
+		{Field: "Name", Err: svalidator.ErrTooBig},
+		{Field: "Price", Err: svalidator.ErrTooSmall},
+		{Field: "Tags", Err: svalidator.ErrTooBig},
+	}, v.ValidateGroup(Item{Name: "long", Price: -1, Tags: []string{"a", "b", "c"}}, groupUpdate))
+	assertError(t, svalidator.ErrNotEqual, v.Validate(Item{}))
+}
+
+func TestSafeObject_UndeclaredGroup(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		object svalidator.ValidatorMap[groupUser]
+	}{
+		{"entry", svalidator.ValidatorMap[groupUser]{
+			"ID": svalidator.InGroup[string](svalidator.String().Required(), "undeclared"),
+		}},
+		{"rule", svalidator.ValidatorMap[groupUser]{
+			"Name": svalidator.String().Required().AppendValidator(svalidator.ExceptGroup[string](svalidator.String().Max(1), "undeclared")),
+		}},
+		{"slice element", svalidator.ValidatorMap[groupUser]{
+			"Tags": svalidator.Slice[string](svalidator.InGroup[string](svalidator.String().Required(), groupCreate, "undeclared")),
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svalidator.SafeObject(tt.object, testGroups)
+			if !errors.Is(err, svalidator.ErrUndeclaredGroup) {
+				t.Errorf("want ErrUndeclaredGroup, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSafeObject_UndeclaredGroupInWrapper(t *testing.T) {
+	type Wrapped struct {
+		Null    sql.NullString
+		Lookup  string
+		Map     map[string]any
+		Array   []any
+		Nested  groupUser
+		Warning string
+		Time    string
+	}
+	undeclared := svalidator.InGroup[string](svalidator.String().Required(), "undeclared")
+	for _, tt := range []struct {
+		name      string
+		field     string
+		validator svalidator.AnyValidator
+	}{
+		{"null", "Null", svalidator.NullString(undeclared)},
+		{"lookup", "Lookup", svalidator.Lookup[string](func(_ context.Context, keys []string) ([]error, error) {
+			return make([]error, len(keys)), nil
+		}).AppendValidator(undeclared)},
+		{"map", "Map", svalidator.Map(svalidator.AnyValidatorMap{"name": undeclared})},
+		{"map key", "Map", svalidator.Map(svalidator.AnyValidatorMap{"name": svalidator.Key(undeclared, svalidator.KeyOptional)})},
+		{"array", "Array", svalidator.Array(undeclared)},
+		{"nested object", "Nested", groupUserValidator().AppendValidator(svalidator.InGroup[groupUser](groupUserValidator(), "undeclared"))},
+		{"warning", "Warning", svalidator.Warning[string](undeclared)},
+		{"parse time", "Time", svalidator.String().RFC3339(svalidator.InGroup[time.Time](svalidator.Time().Required(), "undeclared"))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svalidator.SafeObject(svalidator.ValidatorMap[Wrapped]{tt.field: tt.validator}, testGroups)
+			if !errors.Is(err, svalidator.ErrUndeclaredGroup) {
+				t.Errorf("want ErrUndeclaredGroup, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGroups_DeclarePanic(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		declare func()
+	}{
+		{"empty", func() { svalidator.NewGroups().Declare("") }},
+		{"redeclare with other parents", func() { testGroups.Declare("admin", groupCreate) }},
+		{"undeclared parent", func() { svalidator.NewGroups().Declare("child", "undeclared") }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("want panic")
+				}
+			}()
+			tt.declare()
+		})
+	}
+}
+
+func TestGroups_Scope(t *testing.T) {
+	// declaring the same group with the same parents is allowed.
+	if g := testGroups.Declare("admin", groupUpdate); g != groupAdmin {
+		t.Errorf("want %s, got %s", groupAdmin, g)
+	}
+
+	// groups of other Groups are not declared for the object.
+	other := svalidator.NewGroups()
+	review := other.Declare("review")
+	v := svalidator.ValidatorMap[groupUser]{
+		"Name": svalidator.InGroup[string](svalidator.String().Required(), review),
+	}
+	if _, err := svalidator.SafeObject(v, testGroups); !errors.Is(err, svalidator.ErrUndeclaredGroup) {
+		t.Errorf("want ErrUndeclaredGroup, got %v", err)
+	}
+	o, err := svalidator.SafeObject(v, testGroups, other)
+	assertError(t, nil, err)
+	assertError(t, svalidator.ErrObject{{Field: "Name", Err: svalidator.ErrEmpty}}, o.ValidateGroup(groupUser{}, review))
+	assertError(t, svalidator.ErrUndeclaredGroup, svalidator.Object(svalidator.ValidatorMap[groupUser]{}).ValidateGroup(groupUser{}, groupUpdate))
+
+	// independent Groups may declare the same name.
+	another := svalidator.NewGroups()
+	if g := another.Declare("update", another.Declare("base")); g != groupUpdate {
+		t.Errorf("want %s, got %s", groupUpdate, g)
+	}
+}
@@ -0,0 +1,136 @@
+package svalidator
+
+import "time"
+
+// JapaneseHolidays is HolidayCalendar of Japanese national holidays since 1949.
+// Holidays are computed by the Act on National Holidays, including the equinox days,
+// substitute holidays and citizens' holidays, so it works without any data source.
+// The date of input is used as is, so convert input to Asia/Tokyo beforehand if needed.
+var JapaneseHolidays HolidayCalendar = japaneseHolidays{}
+
+type japaneseHolidays struct{}
+
+var (
+	// substitute holidays start from 1973-04-12, and are extended by the amendment of 2007.
+	jpSubstituteStart         = time.Date(1973, 4, 12, 0, 0, 0, 0, time.UTC)
+	jpSubstituteExtendedStart = time.Date(2007, 1, 1, 0, 0, 0, 0, time.UTC)
+	jpCitizensHolidayStart    = time.Date(1985, 12, 27, 0, 0, 0, 0, time.UTC)
+	// jpSpecialHolidays are holidays for imperial events in yyyymmdd.
+	jpSpecialHolidays = map[int]bool{
+		19590410: true,
+		19890224: true,
+		19901112: true,
+		19930609: true,
+		20190501: true,
+		20191022: true,
+	}
+)
+
+func (japaneseHolidays) IsHoliday(t time.Time) bool {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if jpNationalHoliday(day) {
+		return true
+	}
+
+	// a day between national holidays is a citizens' holiday.
+	if !day.Before(jpCitizensHolidayStart) && day.Weekday() != time.Sunday &&
+		jpNationalHoliday(day.AddDate(0, 0, -1)) && jpNationalHoliday(day.AddDate(0, 0, 1)) {
+		return true
+	}
+
+	// a national holiday on Sunday moves to the next day which is not a national holiday.
+	switch {
+	case day.Before(jpSubstituteStart):
+		return false
+	case day.Before(jpSubstituteExtendedStart):
+		prev := day.AddDate(0, 0, -1)
+		return prev.Weekday() == time.Sunday && jpNationalHoliday(prev)
+	}
+	for prev := day.AddDate(0, 0, -1); jpNationalHoliday(prev); prev = prev.AddDate(0, 0, -1) {
+		if prev.Weekday() == time.Sunday {
+			return true
+		}
+	}
+	return false
+}
+
+// jpNationalHoliday reports whether day is a national holiday defined by name.
+func jpNationalHoliday(day time.Time) bool {
+	y, m, d := day.Date()
+	if y < 1949 {
+		return false
+	}
+	if jpSpecialHolidays[y*10000+int(m)*100+d] {
+		return true
+	}
+	switch m {
+	case time.January:
+		return d == 1 ||
+			y <= 1999 && d == 15 ||
+			y >= 2000 && d == nthWeekday(y, m, 2, time.Monday)
+	case time.February:
+		return y >= 1967 && d == 11 ||
+			y >= 2020 && d == 23
+	case time.March:
+		return d == vernalEquinoxDay(y)
+	case time.April:
+		return d == 29
+	case time.May:
+		return d == 3 || d == 5 || y >= 2007 && d == 4
+	case time.July:
+		switch y {
+		case 2020:
+			return d == 23 || d == 24
+		case 2021:
+			return d == 22 || d == 23
+		}
+		return 1996 <= y && y <= 2002 && d == 20 ||
+			y >= 2003 && d == nthWeekday(y, m, 3, time.Monday)
+	case time.August:
+		switch y {
+		case 2020:
+			return d == 10
+		case 2021:
+			return d == 8
+		}
+		return y >= 2016 && d == 11
+	case time.September:
+		return 1966 <= y && y <= 2002 && d == 15 ||
+			y >= 2003 && d == nthWeekday(y, m, 3, time.Monday) ||
+			d == autumnalEquinoxDay(y)
+	case time.October:
+		return 1966 <= y && y <= 1999 && d == 10 ||
+			(2000 <= y && y <= 2019 || y >= 2022) && d == nthWeekday(y, m, 2, time.Monday)
+	case time.November:
+		return d == 3 || d == 23
+	case time.December:
+		return 1989 <= y && y <= 2018 && d == 23
+	}
+	return false
+}
+
+// nthWeekday returns the day of the nth weekday in the month.
+func nthWeekday(year int, month time.Month, n int, weekday time.Weekday) int {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	return 1 + (int(weekday)-int(first)+7)%7 + (n-1)*7
+}
+
+func vernalEquinoxDay(year int) int {
+	return equinoxDay(year, 20.8357, 20.8431, 21.8510)
+}
+
+func autumnalEquinoxDay(year int) int {
+	return equinoxDay(year, 23.2588, 23.2488, 24.2488)
+}
+
+// equinoxDay approximates the equinox day with the base day for 1900-1979, 1980-2099 and 2100-2150.
+func equinoxDay(year int, base1900, base1980, base2100 float64) int {
+	switch {
+	case year < 1980:
+		return int(base1900 + 0.242194*float64(year-1980) - float64((year-1983)/4))
+	case year < 2100:
+		return int(base1980 + 0.242194*float64(year-1980) - float64((year-1980)/4))
+	}
+	return int(base2100 + 0.242194*float64(year-1980) - float64((year-1980)/4))
+}
@@ -0,0 +1,54 @@
+package svalidator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestJapaneseHolidays(t *testing.T) {
+	for _, tt := range []struct {
+		date    string
+		holiday bool
+	}{
+		{"2024-01-01", true},
+		{"2024-01-08", true},  // Coming of Age Day
+		{"1999-01-15", true},  // Coming of Age Day before Happy Monday
+		{"2024-02-12", true},  // substitute of National Foundation Day
+		{"2025-02-24", true},  // substitute of Emperor's Birthday
+		{"2024-03-20", true},  // Vernal Equinox Day
+		{"2024-03-21", false}, //
+		{"1960-03-20", true},  // Vernal Equinox Day
+		{"2023-09-23", true},  // Autumnal Equinox Day
+		{"2015-09-22", true},  // citizens' holiday
+		{"2019-04-30", true},  // citizens' holiday around the accession
+		{"2019-05-01", true},  // accession of the Emperor
+		{"2019-05-02", true},  // citizens' holiday around the accession
+		{"2019-05-06", true},  // substitute of Children's Day
+		{"2008-05-06", true},  // substitute over consecutive holidays
+		{"2004-05-04", true},  // citizens' holiday before Greenery Day moved
+		{"2018-12-24", true},  // substitute of Emperor's Birthday
+		{"2019-12-23", false}, // no Emperor's Birthday in 2019
+		{"2020-07-24", true},  // Sports Day moved for the Olympics
+		{"2020-10-12", false}, //
+		{"2021-08-09", true},  // substitute of Mountain Day moved for the Olympics
+		{"2024-08-12", true},  // substitute of Mountain Day
+		{"2024-10-14", true},  // Sports Day
+		{"1973-04-30", true},  // the first substitute holiday
+		{"1973-02-12", false}, // before substitute holidays
+		{"2024-11-04", true},  // substitute of Culture Day
+		{"2024-06-03", false},
+		{"1948-01-01", false},
+	} {
+		t.Run(tt.date, func(t *testing.T) {
+			date, err := time.Parse("2006-01-02", tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := svalidator.JapaneseHolidays.IsHoliday(date); got != tt.holiday {
+				t.Errorf("want: %t.\nbut got: %t", tt.holiday, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package svalidator
+
+import "strings"
+
+// JPOption configures rules for Japanese identifiers.
+type JPOption int
+
+const (
+	// JPNormalize converts full-width digits and hyphen-like characters
+	// such as "－" and "ー" to ASCII before checking.
+	JPNormalize JPOption = 1 << iota
+	// JPRequireHyphen requires hyphens such as "100-0001".
+	JPRequireHyphen
+	// JPNoHyphen forbids hyphens such as "1000001".
+	JPNoHyphen
+)
+
+// PhoneType is a kind of Japanese phone number.
+type PhoneType int
+
+const (
+	// PhoneLandline is a fixed line number such as "03-1234-5678".
+	PhoneLandline PhoneType = 1 << iota
+	// PhoneMobile is a mobile number beginning with 070, 080 or 090.
+	PhoneMobile
+	// PhoneIP is an IP phone number beginning with 050.
+	PhoneIP
+	// PhoneTollFree is a toll-free number beginning with 0120 or 0800.
+	PhoneTollFree
+	// PhoneNavi is a shared cost number beginning with 0570.
+	PhoneNavi
+
+	PhoneAny = PhoneLandline | PhoneMobile | PhoneIP | PhoneTollFree | PhoneNavi
+)
+
+// PostalCode adds a validate whether input is a Japanese postal code such as "100-0001".
+// Without JPRequireHyphen or JPNoHyphen, the hyphen is optional.
+func (s *UStringValidator[T]) PostalCode(opts ...JPOption) *UStringValidator[T] {
+	return s.check(func(str string) error { return checkPostalCode(str, opts) })
+}
+
+// PhoneNumber adds a validate whether input is a Japanese domestic phone number of types.
+// When input is hyphenated, the length of each group is also checked.
+func (s *UStringValidator[T]) PhoneNumber(types PhoneType, opts ...JPOption) *UStringValidator[T] {
+	return s.check(func(str string) error { return checkPhoneNumber(str, types, opts) })
+}
+
+// MyNumber adds a validate whether input is an individual number (My Number) with a valid check digit.
+func (s *UStringValidator[T]) MyNumber(opts ...JPOption) *UStringValidator[T] {
+	return s.check(func(str string) error { return checkMyNumber(str, opts) })
+}
+
+// CorporateNumber adds a validate whether input is a 13 digits corporate number with a valid check digit.
+func (s *UStringValidator[T]) CorporateNumber(opts ...JPOption) *UStringValidator[T] {
+	return s.check(func(str string) error { return checkCorporateNumber(str, opts) })
+}
+
+// PostalCode adds a validate whether input is a Japanese postal code.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) PostalCode(opts ...JPOption) *PointerUStringValidator[T] {
+	return s.check(func(str string) error { return checkPostalCode(str, opts) })
+}
+
+// PhoneNumber adds a validate whether input is a Japanese domestic phone number of types.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) PhoneNumber(types PhoneType, opts ...JPOption) *PointerUStringValidator[T] {
+	return s.check(func(str string) error { return checkPhoneNumber(str, types, opts) })
+}
+
+// MyNumber adds a validate whether input is an individual number (My Number) with a valid check digit.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MyNumber(opts ...JPOption) *PointerUStringValidator[T] {
+	return s.check(func(str string) error { return checkMyNumber(str, opts) })
+}
+
+// CorporateNumber adds a validate whether input is a corporate number with a valid check digit.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) CorporateNumber(opts ...JPOption) *PointerUStringValidator[T] {
+	return s.check(func(str string) error { return checkCorporateNumber(str, opts) })
+}
+
+func jpOptions(opts []JPOption) JPOption {
+	var o JPOption
+	for _, opt := range opts {
+		o |= opt
+	}
+	return o
+}
+
+var jpNormalizer = strings.NewReplacer(
+	"０", "0", "１", "1", "２", "2", "３", "3", "４", "4",
+	"５", "5", "６", "6", "７", "7", "８", "8", "９", "9",
+	"－", "-", "‐", "-", "‑", "-", "‒", "-", "–", "-",
+	"—", "-", "―", "-", "−", "-", "ー", "-", "ｰ", "-",
+)
+
+// splitDigits normalizes s by o and splits it by hyphens.
+// It returns false when s contains characters other than digits and hyphens
+// or violates the hyphen option.
+func splitDigits(s string, o JPOption) ([]string, bool) {
+	if o&JPNormalize != 0 {
+		s = jpNormalizer.Replace(s)
+	}
+	groups := strings.Split(s, "-")
+	if o&JPRequireHyphen != 0 && len(groups) == 1 || o&JPNoHyphen != 0 && len(groups) > 1 {
+		return nil, false
+	}
+	for _, g := range groups {
+		if len(g) == 0 || !isDigits(g) {
+			return nil, false
+		}
+	}
+	return groups, true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func checkPostalCode(s string, opts []JPOption) error {
+	groups, ok := splitDigits(s, jpOptions(opts))
+	switch {
+	case !ok:
+		return ErrInvalidPostalCode
+	case len(groups) == 1 && len(groups[0]) == 7:
+		return nil
+	case len(groups) == 2 && len(groups[0]) == 3 && len(groups[1]) == 4:
+		return nil
+	}
+	return ErrInvalidPostalCode
+}
+
+func checkPhoneNumber(s string, types PhoneType, opts []JPOption) error {
+	groups, ok := splitDigits(s, jpOptions(opts))
+	if !ok || len(groups) != 1 && len(groups) != 3 {
+		return ErrInvalidPhoneNumber
+	}
+	digits := strings.Join(groups, "")
+	if types == 0 {
+		types = PhoneAny
+	}
+
+	var (
+		typ    PhoneType
+		length int
+		first  []int // allowed lengths of the first group.
+		second []int // allowed lengths of the second group.
+	)
+	switch {
+	case strings.HasPrefix(digits, "0120"), strings.HasPrefix(digits, "0570"):
+		typ, length, first, second = PhoneTollFree, 10, []int{4}, []int{3}
+		if digits[1] == '5' {
+			typ = PhoneNavi
+		}
+	case strings.HasPrefix(digits, "0800"):
+		typ, length, first, second = PhoneTollFree, 11, []int{4}, []int{3}
+	case strings.HasPrefix(digits, "070"), strings.HasPrefix(digits, "080"), strings.HasPrefix(digits, "090"):
+		typ, length, first, second = PhoneMobile, 11, []int{3}, []int{4}
+	case strings.HasPrefix(digits, "050"):
+		typ, length, first, second = PhoneIP, 11, []int{3}, []int{4}
+	case len(digits) > 2 && digits[0] == '0' && digits[1] != '0' && digits[2] != '0':
+		// the area code and the local exchange code are 6 digits in total.
+		typ, length, first = PhoneLandline, 10, []int{2, 3, 4, 5}
+		if len(groups) == 3 {
+			second = []int{6 - len(groups[0])}
+		}
+	default:
+		return ErrInvalidPhoneNumber
+	}
+
+	if types&typ == 0 || len(digits) != length {
+		return ErrInvalidPhoneNumber
+	}
+	if len(groups) == 3 && (!containsInt(first, len(groups[0])) || !containsInt(second, len(groups[1]))) {
+		return ErrInvalidPhoneNumber
+	}
+	return nil
+}
+
+func containsInt(list []int, target int) bool {
+	for _, v := range list {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+func checkMyNumber(s string, opts []JPOption) error {
+	groups, ok := splitDigits(s, jpOptions(opts)|JPNoHyphen)
+	if !ok || len(groups[0]) != 12 {
+		return ErrInvalidMyNumber
+	}
+	digits := groups[0]
+
+	var sum int
+	for n := 1; n <= 11; n++ {
+		p := int(digits[11-n] - '0')
+		q := n + 1
+		if n > 6 {
+			q = n - 5
+		}
+		sum += p * q
+	}
+	check := 0
+	if r := sum % 11; r > 1 {
+		check = 11 - r
+	}
+	if int(digits[11]-'0') != check {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func checkCorporateNumber(s string, opts []JPOption) error {
+	groups, ok := splitDigits(s, jpOptions(opts)|JPNoHyphen)
+	if !ok || len(groups[0]) != 13 {
+		return ErrInvalidCorporateNumber
+	}
+	digits := groups[0]
+
+	var sum int
+	for n := 1; n <= 12; n++ {
+		p := int(digits[13-n] - '0')
+		sum += p * (2 - n%2)
+	}
+	if int(digits[0]-'0') != 9-sum%9 {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
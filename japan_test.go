@@ -0,0 +1,78 @@
+package svalidator_test
+
+import (
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestUString_Japan(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.StringValidator
+			input     string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"postal code", args{svalidator.String().PostalCode(), "100-0001"}, nil},
+		{"postal code without hyphen", args{svalidator.String().PostalCode(), "1000001"}, nil},
+		{"postal code requires hyphen", args{svalidator.String().PostalCode(svalidator.JPRequireHyphen), "1000001"}, svalidator.ErrInvalidPostalCode},
+		{"postal code forbids hyphen", args{svalidator.String().PostalCode(svalidator.JPNoHyphen), "100-0001"}, svalidator.ErrInvalidPostalCode},
+		{"postal code full width", args{svalidator.String().PostalCode(), "１００－０００１"}, svalidator.ErrInvalidPostalCode},
+		{"postal code normalize", args{svalidator.String().PostalCode(svalidator.JPNormalize), "１００－０００１"}, nil},
+		{"postal code length", args{svalidator.String().PostalCode(), "10-00001"}, svalidator.ErrInvalidPostalCode},
+		{"landline", args{svalidator.String().PhoneNumber(svalidator.PhoneAny), "03-1234-5678"}, nil},
+		{"landline 5 digits area code", args{svalidator.String().PhoneNumber(svalidator.PhoneLandline), "01267-2-3456"}, nil},
+		{"landline without hyphen", args{svalidator.String().PhoneNumber(svalidator.PhoneLandline), "0312345678"}, nil},
+		{"landline group length", args{svalidator.String().PhoneNumber(svalidator.PhoneLandline), "03-123-45678"}, svalidator.ErrInvalidPhoneNumber},
+		{"landline digits", args{svalidator.String().PhoneNumber(svalidator.PhoneLandline), "03-1234-567"}, svalidator.ErrInvalidPhoneNumber},
+		{"mobile", args{svalidator.String().PhoneNumber(svalidator.PhoneMobile), "090-1234-5678"}, nil},
+		{"mobile normalize", args{svalidator.String().PhoneNumber(svalidator.PhoneMobile, svalidator.JPNormalize), "０９０ー１２３４ー５６７８"}, nil},
+		{"mobile only", args{svalidator.String().PhoneNumber(svalidator.PhoneMobile), "03-1234-5678"}, svalidator.ErrInvalidPhoneNumber},
+		{"toll free", args{svalidator.String().PhoneNumber(svalidator.PhoneTollFree), "0120-123-456"}, nil},
+		{"toll free 0800", args{svalidator.String().PhoneNumber(svalidator.PhoneTollFree), "0800-123-4567"}, nil},
+		{"toll free group length", args{svalidator.String().PhoneNumber(svalidator.PhoneTollFree), "0120-12-3456"}, svalidator.ErrInvalidPhoneNumber},
+		{"navi dial", args{svalidator.String().PhoneNumber(0), "0570-123-456"}, nil},
+		{"ip phone", args{svalidator.String().PhoneNumber(svalidator.PhoneIP), "050-1234-5678"}, nil},
+		{"international prefix", args{svalidator.String().PhoneNumber(0), "0012345678"}, svalidator.ErrInvalidPhoneNumber},
+		{"my number", args{svalidator.String().MyNumber(), "123456789018"}, nil},
+		{"my number checksum", args{svalidator.String().MyNumber(), "123456789012"}, svalidator.ErrInvalidChecksum},
+		{"my number format", args{svalidator.String().MyNumber(), "1234-5678-9018"}, svalidator.ErrInvalidMyNumber},
+		{"my number normalize", args{svalidator.String().MyNumber(svalidator.JPNormalize), "１２３４５６７８９０１８"}, nil},
+		{"corporate number", args{svalidator.String().CorporateNumber(), "7000012050002"}, nil},
+		{"corporate number checksum", args{svalidator.String().CorporateNumber(), "1000012050002"}, svalidator.ErrInvalidChecksum},
+		{"corporate number format", args{svalidator.String().CorporateNumber(), "700001205000"}, svalidator.ErrInvalidCorporateNumber},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
+
+func TestPointerUString_Japan(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.PointerStringValidator
+			input     *string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"nil", args{svalidator.PointerString().PostalCode().PhoneNumber(0).MyNumber().CorporateNumber(), nil}, nil},
+		{"postal code error", args{svalidator.PointerString().PostalCode(), pointer("100")}, svalidator.ErrInvalidPostalCode},
+		{"my number checksum", args{svalidator.PointerString().MyNumber(), pointer("123456789012")}, svalidator.ErrInvalidChecksum},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
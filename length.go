@@ -0,0 +1,109 @@
+package svalidator
+
+// MaxBytes adds a validate whether the byte length of input is less than or equal to m.
+func (s *UStringValidator[T]) MaxBytes(m int) *UStringValidator[T] {
+	return s.maxLength(m, byteCount)
+}
+
+// MinBytes adds a validate whether the byte length of input is greater than or equal to m.
+func (s *UStringValidator[T]) MinBytes(m int) *UStringValidator[T] {
+	return s.minLength(m, byteCount)
+}
+
+// MaxGraphemes adds a validate whether the number of grapheme clusters of input is less than or equal to m.
+// A grapheme cluster is a user-perceived character such as "👨‍👩‍👧" or "が".
+func (s *UStringValidator[T]) MaxGraphemes(m int) *UStringValidator[T] {
+	return s.maxLength(m, graphemeCount)
+}
+
+// MinGraphemes adds a validate whether the number of grapheme clusters of input is greater than or equal to m.
+func (s *UStringValidator[T]) MinGraphemes(m int) *UStringValidator[T] {
+	return s.minLength(m, graphemeCount)
+}
+
+// MaxWidth adds a validate whether the display width of input is less than or equal to m.
+// East Asian wide and full-width characters count as 2, and ambiguous characters count as 1.
+func (s *UStringValidator[T]) MaxWidth(m int) *UStringValidator[T] {
+	return s.maxLength(m, displayWidth)
+}
+
+// MinWidth adds a validate whether the display width of input is greater than or equal to m.
+func (s *UStringValidator[T]) MinWidth(m int) *UStringValidator[T] {
+	return s.minLength(m, displayWidth)
+}
+
+func (s *UStringValidator[T]) maxLength(m int, length func(string) int) *UStringValidator[T] {
+	return s.AppendValidate(func(value T) error {
+		if length(string(value)) > m {
+			return ErrTooBig
+		}
+		return nil
+	})
+}
+
+func (s *UStringValidator[T]) minLength(m int, length func(string) int) *UStringValidator[T] {
+	return s.AppendValidate(func(value T) error {
+		if length(string(value)) < m {
+			return ErrTooSmall
+		}
+		return nil
+	})
+}
+
+// MaxBytes adds a validate whether the byte length of input is less than or equal to m.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MaxBytes(m int) *PointerUStringValidator[T] {
+	return s.maxLength(m, byteCount)
+}
+
+// MinBytes adds a validate whether the byte length of input is greater than or equal to m.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MinBytes(m int) *PointerUStringValidator[T] {
+	return s.minLength(m, byteCount)
+}
+
+// MaxGraphemes adds a validate whether the number of grapheme clusters of input is less than or equal to m.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MaxGraphemes(m int) *PointerUStringValidator[T] {
+	return s.maxLength(m, graphemeCount)
+}
+
+// MinGraphemes adds a validate whether the number of grapheme clusters of input is greater than or equal to m.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MinGraphemes(m int) *PointerUStringValidator[T] {
+	return s.minLength(m, graphemeCount)
+}
+
+// MaxWidth adds a validate whether the display width of input is less than or equal to m.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MaxWidth(m int) *PointerUStringValidator[T] {
+	return s.maxLength(m, displayWidth)
+}
+
+// MinWidth adds a validate whether the display width of input is greater than or equal to m.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) MinWidth(m int) *PointerUStringValidator[T] {
+	return s.minLength(m, displayWidth)
+}
+
+func (s *PointerUStringValidator[T]) maxLength(m int, length func(string) int) *PointerUStringValidator[T] {
+	return s.AppendValidate(func(value *T) error {
+		if value != nil && length(string(*value)) > m {
+			return ErrTooBig
+		}
+		return nil
+	})
+}
+
+func (s *PointerUStringValidator[T]) minLength(m int, length func(string) int) *PointerUStringValidator[T] {
+	return s.AppendValidate(func(value *T) error {
+		if value != nil && length(string(*value)) < m {
+			return ErrTooSmall
+		}
+		return nil
+	})
+}
+
+func byteCount(s string) int {
+	return len(s)
+}
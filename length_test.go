@@ -0,0 +1,132 @@
+package svalidator_test
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestUString_Length(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.StringValidator
+			input     string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"max bytes", args{svalidator.String().MaxBytes(6), "あい"}, nil},
+		{"max bytes error", args{svalidator.String().MaxBytes(5), "あい"}, svalidator.ErrTooBig},
+		{"min bytes error", args{svalidator.String().MinBytes(7), "あい"}, svalidator.ErrTooSmall},
+		{"zwj sequence is one grapheme", args{svalidator.String().MaxGraphemes(1), "👨‍👩‍👧"}, nil},
+		{"flag is one grapheme", args{svalidator.String().MaxGraphemes(2), "🇯🇵🇺🇸"}, nil},
+		{"regional indicators are paired", args{svalidator.String().MaxGraphemes(1), "🇯🇵🇺"}, svalidator.ErrTooBig},
+		{"combining mark", args{svalidator.String().MaxGraphemes(1), "が"}, nil},
+		{"skin tone modifier", args{svalidator.String().MaxGraphemes(1), "👍🏽"}, nil},
+		{"hangul jamo", args{svalidator.String().MaxGraphemes(1), "각"}, nil},
+		{"crlf", args{svalidator.String().MaxGraphemes(1), "\r\n"}, nil},
+		{"prepend", args{svalidator.String().MaxGraphemes(1), "\u0600\u0661"}, nil},
+		{"zwj before non pictographic", args{svalidator.String().MaxGraphemes(1), "☕\u200d✓"}, svalidator.ErrTooBig},
+		{"min graphemes error", args{svalidator.String().MinGraphemes(2), "👨‍👩‍👧"}, svalidator.ErrTooSmall},
+		{"max graphemes error", args{svalidator.String().MaxGraphemes(2), "abc"}, svalidator.ErrTooBig},
+		{"full width", args{svalidator.String().MaxWidth(4), "日本"}, nil},
+		{"full width error", args{svalidator.String().MaxWidth(3), "日本"}, svalidator.ErrTooBig},
+		{"half width katakana", args{svalidator.String().MaxWidth(3), "ｶﾞｷ"}, nil},
+		{"half width dakuten", args{svalidator.String().MaxWidth(3), "ｶﾞｷﾞ"}, svalidator.ErrTooBig},
+		{"emoji modifier width", args{svalidator.String().MaxWidth(2), "👍🏽"}, nil},
+		{"emoji zwj sequence width", args{svalidator.String().MaxWidth(2), "👨‍👩‍👧"}, nil},
+		{"hangul jamo width", args{svalidator.String().MaxWidth(2), "\u1112\u1161\u11ab"}, nil},
+		{"emoji width", args{svalidator.String().MinWidth(2), "😄"}, nil},
+		{"combining mark width", args{svalidator.String().MaxWidth(1), "é"}, nil},
+		{"min width error", args{svalidator.String().MinWidth(3), "ab"}, svalidator.ErrTooSmall},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
+
+func TestPointerUString_Length(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.PointerStringValidator
+			input     *string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"nil", args{svalidator.PointerString().MinBytes(1).MinGraphemes(1).MinWidth(1), nil}, nil},
+		{"max bytes error", args{svalidator.PointerString().MaxBytes(1), pointer("ab")}, svalidator.ErrTooBig},
+		{"max graphemes", args{svalidator.PointerString().MaxGraphemes(1), pointer("👨‍👩‍👧")}, nil},
+		{"max width error", args{svalidator.PointerString().MaxWidth(1), pointer("あ")}, svalidator.ErrTooBig},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
+
+// TestUString_GraphemeBreakTest checks grapheme cluster boundaries with testdata/GraphemeBreakTest.txt.
+// The prefix of a line up to the k-th boundary must have exactly k grapheme clusters.
+func TestUString_GraphemeBreakTest(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "GraphemeBreakTest.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for n := 1; s.Scan(); n++ {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		var (
+			input     strings.Builder
+			prefixes  []string
+			surrogate bool
+		)
+		for _, field := range fields[1:] {
+			switch field {
+			case "÷":
+				prefixes = append(prefixes, input.String())
+			case "×":
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				if err != nil {
+					t.Fatalf("line %d: %v", n, err)
+				}
+				surrogate = surrogate || utf16.IsSurrogate(rune(r))
+				input.WriteRune(rune(r))
+			}
+		}
+		// a Go string can not have surrogates.
+		if surrogate {
+			continue
+		}
+		for k, prefix := range prefixes {
+			v := svalidator.String().MinGraphemes(k + 1).MaxGraphemes(k + 1)
+			if err := v.Validate(prefix); err != nil {
+				t.Errorf("line %d: %s: %q must have %d grapheme clusters: %v", n, line, prefix, k+1, err)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,245 @@
+package svalidator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// LookupFunc looks up keys in a batch.
+// It returns an error for each key in the same order as keys, and nil means the key is valid.
+// When it returns a non-nil second value, such as on a database outage,
+// the validation is aborted with ErrLookup instead of reporting errors of the keys.
+type LookupFunc[K comparable] func(ctx context.Context, keys []K) ([]error, error)
+
+// LookupValidator is a validator which needs an external lookup such as a database query.
+//
+// When LookupValidator is used in ObjectValidator, MapValidator or SliceValidator,
+// all keys of the input are looked up in batches before the validation runs.
+type LookupValidator[K comparable] struct {
+	*Validator[K]
+	lookup      LookupFunc[K]
+	batchSize   int
+	concurrency int
+	cache       bool
+}
+
+// Lookup returns LookupValidator.
+func Lookup[K comparable](lookup LookupFunc[K]) *LookupValidator[K] {
+	l := &LookupValidator[K]{lookup: lookup, concurrency: 1}
+	l.Validator = NewContext(func(ctx context.Context, value K) error {
+		if run, ok := ctx.Value(lookupRunKey{}).(*lookupRun); ok {
+			if err, ok := run.result(l, value); ok {
+				return err
+			}
+		}
+		results, err := l.load(ctx, []any{value})
+		if err != nil {
+			return err
+		}
+		return results[value]
+	})
+	return l
+}
+
+// BatchSize sets the max number of keys passed to LookupFunc at once.
+// Zero means no limit.
+func (l *LookupValidator[K]) BatchSize(n int) *LookupValidator[K] {
+	l.batchSize = n
+	return l
+}
+
+// Concurrency sets the max number of LookupFunc calls running at the same time.
+func (l *LookupValidator[K]) Concurrency(n int) *LookupValidator[K] {
+	if n < 1 {
+		n = 1
+	}
+	l.concurrency = n
+	return l
+}
+
+// Cache makes each distinct key looked up only once in a validation run.
+func (l *LookupValidator[K]) Cache() *LookupValidator[K] {
+	l.cache = true
+	return l
+}
+
+func (l *LookupValidator[K]) AppendValidate(funcs ...Validate[K]) *LookupValidator[K] {
+	l.Validator = l.Validator.AppendValidate(funcs...)
+	return l
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (l *LookupValidator[K]) AppendValidator(inner ValueValidator[K]) *LookupValidator[K] {
+	l.Validator = l.Validator.AppendValidator(inner)
+	return l
+}
+
+func (l *LookupValidator[K]) hasLookup() bool {
+	return true
+}
+
+func (l *LookupValidator[K]) collectLookup(ctx context.Context, run *lookupRun, value any) {
+	run.add(l, value)
+}
+
+func (l *LookupValidator[K]) load(ctx context.Context, anyKeys []any) (map[any]error, error) {
+	keys := make([]K, 0, len(anyKeys))
+	seen := make(map[K]bool, len(anyKeys))
+	for _, k := range anyKeys {
+		key := k.(K)
+		if l.cache && seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	size := l.batchSize
+	if size <= 0 {
+		size = len(keys)
+	}
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, l.concurrency)
+		results = make(map[any]error, len(keys))
+		failed  error
+	)
+	// fail records the first error and reports whether any error is recorded.
+	fail := func(err error) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if failed == nil {
+			failed = err
+		}
+		return failed != nil
+	}
+batches:
+	for start := 0; start < len(keys); start += size {
+		end := start + size
+		if end > len(keys) {
+			end = len(keys)
+		}
+		chunk := keys[start:end]
+
+		// no more batches are started after a failure.
+		if fail(ctx.Err()) {
+			break
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			fail(ctx.Err())
+			break batches
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs, err := l.call(ctx, chunk)
+			if err != nil {
+				fail(err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for i, key := range chunk {
+				// keep the first failure of a duplicated key.
+				if err := results[key]; err == nil {
+					results[key] = errs[i]
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if failed != nil {
+		return nil, &ErrLookup{Err: failed}
+	}
+	return results, nil
+}
+
+func (l *LookupValidator[K]) call(ctx context.Context, keys []K) ([]error, error) {
+	errs, err := l.lookup(ctx, keys)
+	if err == nil && len(errs) != len(keys) {
+		err = fmt.Errorf("lookup returns %d results for %d keys", len(errs), len(keys))
+	}
+	return errs, err
+}
+
+// lookupCollector is implemented by validators which may contain LookupValidator.
+// ctx of collectLookup holds the state of enclosing validators such as the coercion of MapValidator.
+type lookupCollector interface {
+	hasLookup() bool
+	collectLookup(ctx context.Context, run *lookupRun, value any)
+}
+
+type lookupLoader interface {
+	load(ctx context.Context, keys []any) (map[any]error, error)
+}
+
+type lookupRunKey struct{}
+
+// lookupRun holds keys and results of lookups in a validation run.
+type lookupRun struct {
+	loaders []lookupLoader
+	keys    map[lookupLoader][]any
+	results map[lookupLoader]map[any]error
+}
+
+func (r *lookupRun) add(l lookupLoader, key any) {
+	if _, exists := r.keys[l]; !exists {
+		r.loaders = append(r.loaders, l)
+	}
+	r.keys[l] = append(r.keys[l], key)
+}
+
+func (r *lookupRun) load(ctx context.Context) error {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		failed error
+	)
+	for _, l := range r.loaders {
+		l := l
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results, err := l.load(ctx, r.keys[l])
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil && failed == nil {
+				failed = err
+			}
+			r.results[l] = results
+		}()
+	}
+	wg.Wait()
+	return failed
+}
+
+func (r *lookupRun) result(l lookupLoader, key any) (error, bool) {
+	err, ok := r.results[l][key]
+	return err, ok
+}
+
+// prefetchLookup looks up all keys contained in value before the validation,
+// and returns ctx holding the results.
+// Nested validators reuse the results of the outermost validator.
+// If a lookup fails as a whole, this returns ErrLookup.
+func prefetchLookup(ctx context.Context, c lookupCollector, value any) (context.Context, error) {
+	if _, ok := ctx.Value(lookupRunKey{}).(*lookupRun); ok || !c.hasLookup() {
+		return ctx, nil
+	}
+	run := &lookupRun{
+		keys:    make(map[lookupLoader][]any),
+		results: make(map[lookupLoader]map[any]error),
+	}
+	c.collectLookup(ctx, run, value)
+	if err := run.load(ctx); err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, lookupRunKey{}, run), nil
+}
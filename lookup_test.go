@@ -0,0 +1,236 @@
+package svalidator_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+// fakeLookup is an in-memory lookup which records its calls.
+type fakeLookup struct {
+	mu      sync.Mutex
+	exists  map[string]bool
+	calls   [][]string
+	running int
+	maxRun  int
+}
+
+func (f *fakeLookup) lookup(ctx context.Context, keys []string) ([]error, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, keys)
+	f.running++
+	if f.running > f.maxRun {
+		f.maxRun = f.running
+	}
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.running--
+		f.mu.Unlock()
+	}()
+
+	errs := make([]error, len(keys))
+	for i, key := range keys {
+		if !f.exists[key] {
+			errs[i] = svalidator.ErrNotFound
+		}
+	}
+	return errs, nil
+}
+
+func TestLookup_Validate(t *testing.T) {
+	f := &fakeLookup{exists: map[string]bool{"a": true}}
+	v := svalidator.Lookup(f.lookup)
+
+	assertError(t, nil, v.Validate("a"))
+	assertError(t, svalidator.ErrNotFound, v.Validate("b"))
+	if len(f.calls) != 2 {
+		t.Errorf("want 2 calls, but got: %v", f.calls)
+	}
+}
+
+func TestLookup_Batch(t *testing.T) {
+	type Item struct {
+		CategoryID string
+	}
+	type Sample struct {
+		OwnerID string
+		Items   []Item
+	}
+
+	for _, tt := range []struct {
+		name      string
+		validator func(f *fakeLookup) *svalidator.LookupValidator[string]
+		calls     int
+		keys      int
+	}{
+		{
+			"one batch",
+			func(f *fakeLookup) *svalidator.LookupValidator[string] {
+				return svalidator.Lookup(f.lookup)
+			},
+			1,
+			5,
+		},
+		{
+			"cache",
+			func(f *fakeLookup) *svalidator.LookupValidator[string] {
+				return svalidator.Lookup(f.lookup).Cache()
+			},
+			1,
+			3,
+		},
+		{
+			"batch size",
+			func(f *fakeLookup) *svalidator.LookupValidator[string] {
+				return svalidator.Lookup(f.lookup).BatchSize(2).Concurrency(2)
+			},
+			3,
+			5,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeLookup{exists: map[string]bool{"owner": true, "a": true}}
+			exists := tt.validator(f)
+			v := svalidator.Object(svalidator.ValidatorMap[Sample]{
+				"OwnerID": exists,
+				"Items": svalidator.Slice[Item](svalidator.Object(svalidator.ValidatorMap[Item]{
+					"CategoryID": exists,
+				})),
+			})
+
+			err := v.Validate(Sample{
+				OwnerID: "owner",
+				Items:   []Item{{"a"}, {"b"}, {"a"}, {"b"}},
+			})
+			assertError(t, svalidator.ErrObject{
+				&svalidator.ErrObjectField{
+					Field: "Items",
+					Err: svalidator.ErrObject{
+						&svalidator.ErrObjectField{
+							Field: "1",
+							Err: svalidator.ErrObject{
+								&svalidator.ErrObjectField{Field: "CategoryID", Err: svalidator.ErrNotFound},
+							},
+						},
+						&svalidator.ErrObjectField{
+							Field: "3",
+							Err: svalidator.ErrObject{
+								&svalidator.ErrObjectField{Field: "CategoryID", Err: svalidator.ErrNotFound},
+							},
+						},
+					},
+				},
+			}, err)
+
+			var keys int
+			for _, call := range f.calls {
+				keys += len(call)
+			}
+			if len(f.calls) != tt.calls || keys != tt.keys {
+				t.Errorf("want %d calls with %d keys, but got: %v", tt.calls, tt.keys, f.calls)
+			}
+			if f.maxRun > 2 {
+				t.Errorf("concurrency exceeds the limit: %d", f.maxRun)
+			}
+		})
+	}
+}
+
+func TestLookup_BatchOrder(t *testing.T) {
+	type Sample struct {
+		C string
+		A string
+		B string
+		D string
+		E string
+	}
+	input := Sample{C: "c", A: "a", B: "b", D: "d", E: "e"}
+	for i := 0; i < 10; i++ {
+		f := &fakeLookup{exists: map[string]bool{}}
+		exists := svalidator.Lookup(f.lookup).BatchSize(2).Concurrency(1)
+		_ = svalidator.Object(svalidator.ValidatorMap[Sample]{
+			"A": exists, "B": exists, "C": exists, "D": exists, "E": exists,
+		}).Validate(input)
+		_ = svalidator.Map(svalidator.AnyValidatorMap{
+			"c": exists, "a": exists, "b": exists, "d": exists, "e": exists,
+		}).Validate(map[string]any{"a": "a", "b": "b", "c": "c", "d": "d", "e": "e"})
+
+		// struct fields are in the declared order, and map keys are sorted.
+		want := [][]string{{"c", "a"}, {"b", "d"}, {"e"}, {"a", "b"}, {"c", "d"}, {"e"}}
+		if !reflect.DeepEqual(f.calls, want) {
+			t.Fatalf("want %v, but got: %v", want, f.calls)
+		}
+	}
+}
+
+func TestLookup_BatchError(t *testing.T) {
+	errDB := errors.New("db error")
+	lookup := svalidator.Lookup(func(ctx context.Context, keys []int) ([]error, error) {
+		return nil, errDB
+	})
+	type Sample struct {
+		ID  int
+		IDs []int
+	}
+	for _, tt := range []struct {
+		name     string
+		validate func() error
+	}{
+		{"single", func() error { return lookup.Validate(1) }},
+		{"slice", func() error { return svalidator.Slice[int](lookup).Validate([]int{1, 2}) }},
+		{"object", func() error {
+			return svalidator.Object(svalidator.ValidatorMap[Sample]{
+				"ID":  lookup,
+				"IDs": svalidator.Slice[int](lookup),
+			}).Validate(Sample{ID: 1, IDs: []int{2}})
+		}},
+		{"optional", func() error { return svalidator.Optional[int](lookup).Validate(pointer(1)) }},
+		{"warning", func() error { return svalidator.Warning[int](lookup).Validate(1) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate()
+			var lerr *svalidator.ErrLookup
+			if !errors.As(err, &lerr) || lerr != err {
+				t.Fatalf("want ErrLookup, but got: %#v", err)
+			}
+			assertError(t, errDB, err)
+		})
+	}
+}
+
+func TestLookup_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	started := make(chan struct{})
+	v := svalidator.Slice[int](svalidator.Lookup(func(ctx context.Context, keys []int) ([]error, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		close(started)
+		<-ctx.Done()
+		return make([]error, len(keys)), nil
+	}).BatchSize(1))
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	err := v.ValidateContext(ctx, []int{1, 2, 3})
+	var lerr *svalidator.ErrLookup
+	if !errors.As(err, &lerr) {
+		t.Fatalf("want ErrLookup, but got: %v", err)
+	}
+	assertError(t, context.Canceled, err)
+	if calls != 1 {
+		t.Errorf("want 1 call, but got: %d", calls)
+	}
+}
@@ -0,0 +1,111 @@
+package svalidator
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// NullValidator is a validator for nullable wrapper types such as sql.NullString.
+type NullValidator[N any, T any] struct {
+	*Validator[N]
+	value  func(N) (T, bool)
+	inner  ValueValidator[T]
+	policy NilPolicy
+}
+
+// Null returns NullValidator for any nullable wrapper type.
+// value extracts the wrapped value and reports whether it is valid (not NULL).
+// By default, NULL input passes.
+func Null[N any, T any](value func(N) (T, bool), inner ValueValidator[T]) *NullValidator[N, T] {
+	n := &NullValidator[N, T]{value: value, inner: inner}
+	n.Validator = NewContext(func(ctx context.Context, input N) error {
+		v, valid := value(input)
+		if !valid {
+			return n.policy.check(true)
+		}
+		if err := n.policy.check(false); err != nil {
+			return err
+		}
+		return unwrapValidate(validateWith(ctx, inner, v))
+	})
+	return n
+}
+
+// NullString returns a validator for sql.NullString.
+func NullString(inner ValueValidator[string]) *NullValidator[sql.NullString, string] {
+	return Null(func(n sql.NullString) (string, bool) { return n.String, n.Valid }, inner)
+}
+
+// NullInt64 returns a validator for sql.NullInt64.
+func NullInt64(inner ValueValidator[int64]) *NullValidator[sql.NullInt64, int64] {
+	return Null(func(n sql.NullInt64) (int64, bool) { return n.Int64, n.Valid }, inner)
+}
+
+// NullInt32 returns a validator for sql.NullInt32.
+func NullInt32(inner ValueValidator[int32]) *NullValidator[sql.NullInt32, int32] {
+	return Null(func(n sql.NullInt32) (int32, bool) { return n.Int32, n.Valid }, inner)
+}
+
+// NullInt16 returns a validator for sql.NullInt16.
+func NullInt16(inner ValueValidator[int16]) *NullValidator[sql.NullInt16, int16] {
+	return Null(func(n sql.NullInt16) (int16, bool) { return n.Int16, n.Valid }, inner)
+}
+
+// NullByte returns a validator for sql.NullByte.
+func NullByte(inner ValueValidator[byte]) *NullValidator[sql.NullByte, byte] {
+	return Null(func(n sql.NullByte) (byte, bool) { return n.Byte, n.Valid }, inner)
+}
+
+// NullFloat64 returns a validator for sql.NullFloat64.
+func NullFloat64(inner ValueValidator[float64]) *NullValidator[sql.NullFloat64, float64] {
+	return Null(func(n sql.NullFloat64) (float64, bool) { return n.Float64, n.Valid }, inner)
+}
+
+// NullBool returns a validator for sql.NullBool.
+func NullBool(inner ValueValidator[bool]) *NullValidator[sql.NullBool, bool] {
+	return Null(func(n sql.NullBool) (bool, bool) { return n.Bool, n.Valid }, inner)
+}
+
+// NullTime returns a validator for sql.NullTime.
+func NullTime(inner ValueValidator[time.Time]) *NullValidator[sql.NullTime, time.Time] {
+	return Null(func(n sql.NullTime) (time.Time, bool) { return n.Time, n.Valid }, inner)
+}
+
+// Nil sets the policy for NULL input.
+func (n *NullValidator[N, T]) Nil(policy NilPolicy) *NullValidator[N, T] {
+	n.policy = policy
+	return n
+}
+
+// Required rejects NULL input.
+func (n *NullValidator[N, T]) Required() *NullValidator[N, T] {
+	return n.Nil(NilRequired)
+}
+
+// Forbidden rejects non-NULL input.
+func (n *NullValidator[N, T]) Forbidden() *NullValidator[N, T] {
+	return n.Nil(NilForbidden)
+}
+
+func (n *NullValidator[N, T]) AppendValidate(funcs ...Validate[N]) *NullValidator[N, T] {
+	n.Validator = n.Validator.AppendValidate(funcs...)
+	return n
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (n *NullValidator[N, T]) AppendValidator(inner ValueValidator[N]) *NullValidator[N, T] {
+	n.Validator = n.Validator.AppendValidator(inner)
+	return n
+}
+
+func (n *NullValidator[N, T]) hasLookup() bool {
+	c, ok := n.inner.(lookupCollector)
+	return ok && c.hasLookup()
+}
+
+func (n *NullValidator[N, T]) collectLookup(ctx context.Context, run *lookupRun, value any) {
+	if v, valid := n.value(value.(N)); valid && n.policy != NilForbidden {
+		n.inner.(lookupCollector).collectLookup(ctx, run, v)
+	}
+}
@@ -0,0 +1,108 @@
+package svalidator_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestNullString_Validate(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.NullValidator[sql.NullString, string]
+			input     sql.NullString
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{
+			"pass",
+			args{
+				validator: svalidator.NullString(svalidator.String().Max(3)),
+				input:     sql.NullString{String: "abc", Valid: true},
+			},
+			nil,
+		},
+		{
+			"too big",
+			args{
+				validator: svalidator.NullString(svalidator.String().Max(3)),
+				input:     sql.NullString{String: "abcd", Valid: true},
+			},
+			svalidator.ErrTooBig,
+		},
+		{
+			"skip null",
+			args{
+				validator: svalidator.NullString(svalidator.String().Required()),
+				input:     sql.NullString{String: "", Valid: false},
+			},
+			nil,
+		},
+		{
+			"null is required error",
+			args{
+				validator: svalidator.NullString(svalidator.String()).Required(),
+				input:     sql.NullString{String: "abc", Valid: false},
+			},
+			svalidator.ErrEmpty,
+		},
+		{
+			"not null is forbidden error",
+			args{
+				validator: svalidator.NullString(svalidator.String()).Forbidden(),
+				input:     sql.NullString{Valid: true},
+			},
+			svalidator.ErrNotEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestNull_Object(t *testing.T) {
+	type Sample struct {
+		Name      sql.NullString
+		Age       sql.NullInt64
+		Score     sql.NullFloat64
+		Active    sql.NullBool
+		CreatedAt sql.NullTime
+	}
+	now := func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) }
+	v, err := svalidator.SafeObject(svalidator.ValidatorMap[Sample]{
+		"Name":      svalidator.NullString(svalidator.String().Max(3)),
+		"Age":       svalidator.NullInt64(svalidator.Number[int64]().Min(0)).Required(),
+		"Score":     svalidator.NullFloat64(svalidator.Number[float64]().Max(100)),
+		"Active":    svalidator.NullBool(svalidator.New[bool]()),
+		"CreatedAt": svalidator.NullTime(svalidator.Time().EqOrBefore(now)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertError(t, nil, v.Validate(Sample{Age: sql.NullInt64{Valid: true}}))
+	assertError(t, svalidator.ErrObject{
+		&svalidator.ErrObjectField{
+			Field: "Age",
+			Err:   svalidator.ErrEmpty,
+		},
+		&svalidator.ErrObjectField{
+			Field: "CreatedAt",
+			Err:   svalidator.ErrTooBig,
+		},
+	}, v.Validate(Sample{CreatedAt: sql.NullTime{Time: now().Add(time.Second), Valid: true}}))
+
+	_, err = svalidator.SafeObject(svalidator.ValidatorMap[Sample]{
+		"Age": svalidator.NullInt32(svalidator.Number[int32]()),
+	})
+	assertIsError(t, true, err)
+}
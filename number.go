@@ -1,5 +1,12 @@
 package svalidator
 
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
 type OrderedNumber interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64 |
 		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
@@ -42,7 +49,13 @@ func (n *NumberValidator[T]) Equal(num T) *NumberValidator[T] {
 }
 
 func (n *NumberValidator[T]) Append(validates ...Validate[T]) *NumberValidator[T] {
-	n.validFuncs = append(n.validFuncs, validates...)
+	n.Validator = n.Validator.AppendValidate(validates...)
+	return n
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (n *NumberValidator[T]) AppendValidator(inner ValueValidator[T]) *NumberValidator[T] {
+	n.Validator = n.Validator.AppendValidator(inner)
 	return n
 }
 
@@ -91,6 +104,272 @@ func (n *PointerNumberValidator[T]) Required() *PointerNumberValidator[T] {
 }
 
 func (n *PointerNumberValidator[T]) Append(validates ...Validate[*T]) *PointerNumberValidator[T] {
-	n.validFuncs = append(n.validFuncs, validates...)
+	n.Validator = n.Validator.AppendValidate(validates...)
+	return n
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (n *PointerNumberValidator[T]) AppendValidator(inner ValueValidator[*T]) *PointerNumberValidator[T] {
+	n.Validator = n.Validator.AppendValidator(inner)
 	return n
 }
+
+// Bounds decides whether Between includes each bound.
+type Bounds int
+
+const (
+	// InclusiveBoth includes both min and max.
+	InclusiveBoth Bounds = iota
+	// ExclusiveBoth excludes both min and max.
+	ExclusiveBoth
+	// InclusiveMin includes min and excludes max.
+	InclusiveMin
+	// InclusiveMax excludes min and includes max.
+	InclusiveMax
+)
+
+// GreaterThan adds a validate whether input is greater than num.
+func (n *NumberValidator[T]) GreaterThan(num T) *NumberValidator[T] {
+	return n.Append(greaterThan(num))
+}
+
+// LessThan adds a validate whether input is less than num.
+func (n *NumberValidator[T]) LessThan(num T) *NumberValidator[T] {
+	return n.Append(lessThan(num))
+}
+
+// Between adds a validate whether input is between min and max.
+func (n *NumberValidator[T]) Between(min, max T, bounds Bounds) *NumberValidator[T] {
+	return n.Append(between(min, max, bounds))
+}
+
+// MultipleOf adds a validate whether input is a multiple of num.
+// Float input is compared with a tolerance of 1e-9 relative to num.
+func (n *NumberValidator[T]) MultipleOf(num T) *NumberValidator[T] {
+	return n.Append(multipleOf(num, defaultTolerance(num)))
+}
+
+// MultipleOfTolerance adds a validate whether input is a multiple of num within tolerance.
+func (n *NumberValidator[T]) MultipleOfTolerance(num T, tolerance float64) *NumberValidator[T] {
+	return n.Append(multipleOf(num, tolerance))
+}
+
+// Positive adds a validate whether input is greater than zero.
+func (n *NumberValidator[T]) Positive() *NumberValidator[T] {
+	return n.GreaterThan(0)
+}
+
+// Negative adds a validate whether input is less than zero.
+func (n *NumberValidator[T]) Negative() *NumberValidator[T] {
+	return n.LessThan(0)
+}
+
+// NonZero adds a validate whether input is not zero.
+func (n *NumberValidator[T]) NonZero() *NumberValidator[T] {
+	return n.Append(nonZero[T])
+}
+
+// Finite adds a validate whether input is neither NaN nor ±Inf.
+// Integer input always passes.
+func (n *NumberValidator[T]) Finite() *NumberValidator[T] {
+	return n.Append(finite[T])
+}
+
+// MaxDecimalPlaces adds a validate whether input has at most places digits after the decimal point.
+// Integer input always passes.
+func (n *NumberValidator[T]) MaxDecimalPlaces(places int) *NumberValidator[T] {
+	return n.Append(maxDecimalPlaces[T](places))
+}
+
+// Enum adds a validate whether input is one of enum.
+func (n *NumberValidator[T]) Enum(enum []T) *NumberValidator[T] {
+	return n.Append(in(enum))
+}
+
+// NotIn adds a validate whether input is none of list.
+func (n *NumberValidator[T]) NotIn(list []T) *NumberValidator[T] {
+	return n.Append(notIn(list))
+}
+
+// GreaterThan adds a validate whether input is greater than num.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) GreaterThan(num T) *PointerNumberValidator[T] {
+	return n.Append(skipNil(greaterThan(num)))
+}
+
+// LessThan adds a validate whether input is less than num.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) LessThan(num T) *PointerNumberValidator[T] {
+	return n.Append(skipNil(lessThan(num)))
+}
+
+// Between adds a validate whether input is between min and max.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) Between(min, max T, bounds Bounds) *PointerNumberValidator[T] {
+	return n.Append(skipNil(between(min, max, bounds)))
+}
+
+// MultipleOf adds a validate whether input is a multiple of num.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) MultipleOf(num T) *PointerNumberValidator[T] {
+	return n.Append(skipNil(multipleOf(num, defaultTolerance(num))))
+}
+
+// MultipleOfTolerance adds a validate whether input is a multiple of num within tolerance.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) MultipleOfTolerance(num T, tolerance float64) *PointerNumberValidator[T] {
+	return n.Append(skipNil(multipleOf(num, tolerance)))
+}
+
+// Positive adds a validate whether input is greater than zero.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) Positive() *PointerNumberValidator[T] {
+	return n.GreaterThan(0)
+}
+
+// Negative adds a validate whether input is less than zero.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) Negative() *PointerNumberValidator[T] {
+	return n.LessThan(0)
+}
+
+// NonZero adds a validate whether input is not zero.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) NonZero() *PointerNumberValidator[T] {
+	return n.Append(skipNil(nonZero[T]))
+}
+
+// Finite adds a validate whether input is neither NaN nor ±Inf.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) Finite() *PointerNumberValidator[T] {
+	return n.Append(skipNil(finite[T]))
+}
+
+// MaxDecimalPlaces adds a validate whether input has at most places digits after the decimal point.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) MaxDecimalPlaces(places int) *PointerNumberValidator[T] {
+	return n.Append(skipNil(maxDecimalPlaces[T](places)))
+}
+
+// Enum adds a validate whether input is one of enum.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) Enum(enum []T) *PointerNumberValidator[T] {
+	return n.Append(skipNil(in(enum)))
+}
+
+// NotIn adds a validate whether input is none of list.
+// If input is nil, returns nil.
+func (n *PointerNumberValidator[T]) NotIn(list []T) *PointerNumberValidator[T] {
+	return n.Append(skipNil(notIn(list)))
+}
+
+func greaterThan[T OrderedNumber](num T) Validate[T] {
+	return func(value T) error {
+		if !(value > num) {
+			return ErrTooSmall
+		}
+		return nil
+	}
+}
+
+func lessThan[T OrderedNumber](num T) Validate[T] {
+	return func(value T) error {
+		if !(value < num) {
+			return ErrTooBig
+		}
+		return nil
+	}
+}
+
+func between[T OrderedNumber](min, max T, bounds Bounds) Validate[T] {
+	minInclusive := bounds == InclusiveBoth || bounds == InclusiveMin
+	maxInclusive := bounds == InclusiveBoth || bounds == InclusiveMax
+	return func(value T) error {
+		if !(value > min || minInclusive && value == min) {
+			return ErrTooSmall
+		}
+		if !(value < max || maxInclusive && value == max) {
+			return ErrTooBig
+		}
+		return nil
+	}
+}
+
+func multipleOf[T OrderedNumber](num T, tolerance float64) Validate[T] {
+	kind := reflect.TypeOf(num).Kind()
+	return func(value T) error {
+		var ok bool
+		switch kind {
+		case reflect.Float32, reflect.Float64:
+			r := math.Abs(math.Mod(float64(value), float64(num)))
+			ok = r <= tolerance || math.Abs(math.Abs(float64(num))-r) <= tolerance
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			ok = num != 0 && int64(value)%int64(num) == 0
+		default:
+			ok = num != 0 && uint64(value)%uint64(num) == 0
+		}
+		if !ok {
+			return ErrNotMultiple
+		}
+		return nil
+	}
+}
+
+func defaultTolerance[T OrderedNumber](num T) float64 {
+	return math.Abs(float64(num)) * 1e-9
+}
+
+func nonZero[T OrderedNumber](value T) error {
+	if value == 0 {
+		return ErrZero
+	}
+	return nil
+}
+
+func finite[T OrderedNumber](value T) error {
+	if f := float64(value); math.IsNaN(f) || math.IsInf(f, 0) {
+		return ErrNotFinite
+	}
+	return nil
+}
+
+func maxDecimalPlaces[T OrderedNumber](places int) Validate[T] {
+	var zero T
+	bitSize := 64
+	switch reflect.TypeOf(zero).Kind() {
+	case reflect.Float32:
+		bitSize = 32
+	case reflect.Float64:
+	default:
+		return func(T) error { return nil }
+	}
+	return func(value T) error {
+		str := strconv.FormatFloat(float64(value), 'f', -1, bitSize)
+		if i := strings.IndexByte(str, '.'); i >= 0 && len(str)-i-1 > places {
+			return ErrTooManyDecimals
+		}
+		return nil
+	}
+}
+
+func in[T comparable](list []T) Validate[T] {
+	return func(value T) error {
+		for _, v := range list {
+			if v == value {
+				return nil
+			}
+		}
+		return ErrMismatchPattern
+	}
+}
+
+func notIn[T comparable](list []T) Validate[T] {
+	return func(value T) error {
+		for _, v := range list {
+			if v == value {
+				return ErrMismatchPattern
+			}
+		}
+		return nil
+	}
+}
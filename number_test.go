@@ -1,6 +1,7 @@
 package svalidator_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/komem3/svalidator"
@@ -159,3 +160,56 @@ func TestPointerNumber_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNumber_Rules(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  func() error
+		want error
+	}{
+		{"greater than", func() error { return svalidator.Number[int]().GreaterThan(2).Validate(3) }, nil},
+		{"not greater than", func() error { return svalidator.Number[int]().GreaterThan(2).Validate(2) }, svalidator.ErrTooSmall},
+		{"less than", func() error { return svalidator.Number[int]().LessThan(2).Validate(1) }, nil},
+		{"not less than", func() error { return svalidator.Number[int]().LessThan(2).Validate(2) }, svalidator.ErrTooBig},
+		{"between inclusive", func() error { return svalidator.Number[int]().Between(1, 3, svalidator.InclusiveBoth).Validate(3) }, nil},
+		{"between exclusive min", func() error { return svalidator.Number[int]().Between(1, 3, svalidator.ExclusiveBoth).Validate(1) }, svalidator.ErrTooSmall},
+		{"between exclusive max", func() error { return svalidator.Number[int]().Between(1, 3, svalidator.InclusiveMin).Validate(3) }, svalidator.ErrTooBig},
+		{"between inclusive max", func() error { return svalidator.Number[int]().Between(1, 3, svalidator.InclusiveMax).Validate(3) }, nil},
+		{"between nan", func() error {
+			return svalidator.Number[float64]().Between(1, 3, svalidator.InclusiveBoth).Validate(math.NaN())
+		}, svalidator.ErrTooSmall},
+		{"multiple of", func() error { return svalidator.Number[int]().MultipleOf(5).Validate(-15) }, nil},
+		{"not multiple of", func() error { return svalidator.Number[uint]().MultipleOf(5).Validate(16) }, svalidator.ErrNotMultiple},
+		{"multiple of zero", func() error { return svalidator.Number[int]().MultipleOf(0).Validate(0) }, svalidator.ErrNotMultiple},
+		{"float multiple of", func() error { return svalidator.Number[float64]().MultipleOf(0.1).Validate(0.3) }, nil},
+		{"float not multiple of", func() error { return svalidator.Number[float64]().MultipleOf(0.1).Validate(0.35) }, svalidator.ErrNotMultiple},
+		{"multiple of tolerance", func() error { return svalidator.Number[float64]().MultipleOfTolerance(0.5, 0.01).Validate(1.005) }, nil},
+		{"positive", func() error { return svalidator.Number[int]().Positive().Validate(1) }, nil},
+		{"not positive", func() error { return svalidator.Number[int]().Positive().Validate(0) }, svalidator.ErrTooSmall},
+		{"not negative", func() error { return svalidator.Number[int]().Negative().Validate(0) }, svalidator.ErrTooBig},
+		{"zero", func() error { return svalidator.Number[int]().NonZero().Validate(0) }, svalidator.ErrZero},
+		{"finite", func() error { return svalidator.Number[int]().Finite().Validate(1) }, nil},
+		{"nan", func() error { return svalidator.Number[float64]().Finite().Validate(math.NaN()) }, svalidator.ErrNotFinite},
+		{"inf", func() error { return svalidator.Number[float32]().Finite().Validate(float32(math.Inf(-1))) }, svalidator.ErrNotFinite},
+		{"decimal places", func() error { return svalidator.Number[float64]().MaxDecimalPlaces(2).Validate(10.25) }, nil},
+		{"too many decimal places", func() error { return svalidator.Number[float64]().MaxDecimalPlaces(2).Validate(10.255) }, svalidator.ErrTooManyDecimals},
+		{"float32 decimal places", func() error { return svalidator.Number[float32]().MaxDecimalPlaces(1).Validate(0.1) }, nil},
+		{"integer decimal places", func() error { return svalidator.Number[int]().MaxDecimalPlaces(0).Validate(10) }, nil},
+		{"enum", func() error { return svalidator.Number[int]().Enum([]int{1, 2}).Validate(2) }, nil},
+		{"not enum", func() error { return svalidator.Number[int]().Enum([]int{1, 2}).Validate(3) }, svalidator.ErrMismatchPattern},
+		{"not in", func() error { return svalidator.Number[int]().NotIn([]int{1, 2}).Validate(1) }, svalidator.ErrMismatchPattern},
+		{"pointer nil", func() error {
+			return svalidator.PointerNumber[float64]().GreaterThan(1).LessThan(0).Between(1, 2, svalidator.ExclusiveBoth).
+				MultipleOf(3).Positive().Negative().NonZero().Finite().MaxDecimalPlaces(0).Enum(nil).NotIn(nil).Validate(nil)
+		}, nil},
+		{"pointer between", func() error {
+			return svalidator.PointerNumber[int]().Between(1, 3, svalidator.ExclusiveBoth).Validate(pointer(3))
+		}, svalidator.ErrTooBig},
+		{"pointer nan", func() error { return svalidator.PointerNumber[float64]().Finite().Validate(pointer(math.NaN())) }, svalidator.ErrNotFinite},
+		{"pointer not in", func() error { return svalidator.PointerNumber[int]().NotIn([]int{1}).Validate(pointer(1)) }, svalidator.ErrMismatchPattern},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assertError(t, tt.want, tt.err())
+		})
+	}
+}
@@ -1,19 +1,28 @@
 package svalidator
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"sort"
 )
 
 // ObjectValidator is validator for struct object.
 type ObjectValidator[T any] struct {
 	*Validator[T]
+	object ValidatorMap[T]
+	order  FieldOrder
+	groups groupSet
 }
 
 type ValidatorMap[T any] map[string]AnyValidator
 
 type MapValidator struct {
 	*Validator[map[string]any]
+	object AnyValidatorMap
+	strict bool
+	doc    docOptions
+	order  FieldOrder
 }
 
 type AnyValidatorMap ValidatorMap[any]
@@ -22,7 +31,8 @@ type AnyValidatorMap ValidatorMap[any]
 //
 // This methods uses the value of the parameter to validate the structure
 // of the argument ValidatorMap. If fails validation, this method returns error.
-func SafeObject[T any](object ValidatorMap[T]) (*ObjectValidator[T], error) {
+// Validation groups used in ValidatorMap must be declared in one of groups.
+func SafeObject[T any](object ValidatorMap[T], groups ...*Groups) (*ObjectValidator[T], error) {
 	var typ T
 	rv := reflect.ValueOf(typ)
 	if rv.Kind() != reflect.Struct {
@@ -36,6 +46,10 @@ func SafeObject[T any](object ValidatorMap[T]) (*ObjectValidator[T], error) {
 			return nil, fmt.Errorf("%s does not exists in %T", field, typ)
 		}
 
+		if _, ok := validator.(*keyValidator); ok {
+			return nil, fmt.Errorf("%s uses Key, which is only for AnyValidatorMap", field)
+		}
+
 		vv := reflect.TypeOf(validator)
 		vFunc, exist := vv.MethodByName("Validate")
 		if !exist {
@@ -45,36 +59,157 @@ func SafeObject[T any](object ValidatorMap[T]) (*ObjectValidator[T], error) {
 		if arg := vFunc.Type.In(1); stField.Type != arg {
 			return nil, fmt.Errorf("struct field type is %s, but field Validator type is %s", stField.Type, arg)
 		}
+		if err := groupSet(groups).check(groupsOf(validator)); err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
 	}
 
-	return &ObjectValidator[T]{
-		Validator: New(func(value T) error {
-			return object.validate(value)
-		}),
-	}, nil
+	o := &ObjectValidator[T]{object: object, groups: groups}
+	o.Validator = NewContext(func(ctx context.Context, value T) error {
+		ctx, err := o.groups.enter(ctx)
+		if err != nil {
+			return err
+		}
+		ctx, err = prefetchLookup(ctx, o, value)
+		if err != nil {
+			return err
+		}
+		return object.validate(ctx, value, o.order)
+	})
+	return o, nil
 }
 
 // Object returns ObjectValidator.
 //
 // This validates structures as same safeObject, but will panic in case of error.
 // Therefore, this is intended for global use.
-func Object[T any](object ValidatorMap[T]) *ObjectValidator[T] {
-	v, err := SafeObject(object)
+func Object[T any](object ValidatorMap[T], groups ...*Groups) *ObjectValidator[T] {
+	v, err := SafeObject(object, groups...)
 	if err != nil {
 		panic(err)
 	}
 	return v
 }
 
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (o *ObjectValidator[T]) AppendValidator(inner ValueValidator[T]) *ObjectValidator[T] {
+	o.Validator = o.Validator.AppendValidator(inner)
+	return o
+}
+
+// Map returns MapValidator.
+//
+// Missing keys, null values and type mismatches are reported in ErrObject together with validation errors.
+// Use Key to allow a key to be absent or null.
 func Map(object AnyValidatorMap) *MapValidator {
-	return &MapValidator{
-		Validator: New(func(value map[string]any) error {
-			return object.validate(value)
-		}),
+	m := &MapValidator{object: object}
+	m.Validator = NewContext(func(ctx context.Context, value map[string]any) error {
+		ctx, level, err := enterDocument(ctx, m.doc, len(value))
+		if err != nil {
+			return err
+		}
+		ctx, err = prefetchLookup(ctx, m, value)
+		if err != nil {
+			return err
+		}
+		err = object.validate(ctx, value, m.strict, level.coercion, m.order)
+		if level.depth == 1 {
+			setPointers(err, "")
+		}
+		return err
+	})
+	return m
+}
+
+// Strict rejects keys which are not in the AnyValidatorMap with ErrUnknownField.
+func (m *MapValidator) Strict() *MapValidator {
+	m.strict = true
+	return m
+}
+
+// Coerce sets the policy for converting JSON values to the input type of validators.
+// Nested MapValidator and ArrayValidator without their own policy inherit it.
+func (m *MapValidator) Coerce(coercion Coercion) *MapValidator {
+	m.doc.coercion = coercion
+	return m
+}
+
+// Limits sets the maximum nesting depth of objects and arrays and the maximum number of
+// keys and elements in the whole document. Zero means no limit.
+// Limits of nested validators are ignored in favor of the root validator.
+func (m *MapValidator) Limits(maxDepth, maxElements int) *MapValidator {
+	m.doc.maxDepth, m.doc.maxElements = maxDepth, maxElements
+	return m
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (m *MapValidator) AppendValidator(inner ValueValidator[map[string]any]) *MapValidator {
+	m.Validator = m.Validator.AppendValidator(inner)
+	return m
+}
+
+func (o *ObjectValidator[T]) hasLookup() bool {
+	for _, validator := range o.object {
+		if c, ok := validator.(lookupCollector); ok && c.hasLookup() {
+			return true
+		}
 	}
+	return false
 }
 
-func (v ValidatorMap[T]) validate(value T) error {
+// collectLookup collects keys in the struct field order, so that batches are deterministic.
+func (o *ObjectValidator[T]) collectLookup(ctx context.Context, run *lookupRun, value any) {
+	rv := reflect.ValueOf(value)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i).Name
+		c, ok := o.object[field].(lookupCollector)
+		if !ok || !c.hasLookup() {
+			continue
+		}
+		if fieldCtx, masked := maskedField(ctx, field); masked {
+			c.collectLookup(fieldCtx, run, rv.Field(i).Interface())
+		}
+	}
+}
+
+func (m *MapValidator) hasLookup() bool {
+	for _, validator := range m.object {
+		validator, _ := keyPolicy(validator)
+		if c, ok := validator.(lookupCollector); ok && c.hasLookup() {
+			return true
+		}
+	}
+	return false
+}
+
+// collectLookup collects keys in the order of sorted field names, so that batches are deterministic.
+func (m *MapValidator) collectLookup(ctx context.Context, run *lookupRun, value any) {
+	ctx, coercion := inheritCoercion(ctx, m.doc.coercion)
+	mv := value.(map[string]any)
+	fields := make([]string, 0, len(m.object))
+	for field := range m.object {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		validator, _ := keyPolicy(m.object[field])
+		c, ok := validator.(lookupCollector)
+		if !ok || !c.hasLookup() {
+			continue
+		}
+		fieldValue, exists := mv[field]
+		if !exists || fieldValue == nil {
+			continue
+		}
+		// type mismatches are reported by validate.
+		if fieldValue, err := coerceValue(fieldValue, validateArgType(validator), coercion); err == nil {
+			c.collectLookup(ctx, run, fieldValue)
+		}
+	}
+}
+
+func (v ValidatorMap[T]) validate(ctx context.Context, value T, order FieldOrder) error {
 	rv := reflect.ValueOf(value)
 	rt := rv.Type()
 	var merr []*ErrObjectField
@@ -84,34 +219,76 @@ func (v ValidatorMap[T]) validate(value T) error {
 		if !exists {
 			continue
 		}
-		if err := validator.validateAny(rv.FieldByName(field.Name).Interface()); err != nil {
+		fieldCtx, masked := maskedField(ctx, field.Name)
+		if !masked {
+			continue
+		}
+		if err := validator.validateAny(withField(fieldCtx, field.Name), rv.FieldByName(field.Name).Interface()); isAbort(err) {
+			return err
+		} else if err != nil {
 			merr = append(merr, newErrObjectField(field.Name, err))
 		}
 	}
+	order.sort(merr, rt)
 	return newErrObject(merr...)
 }
 
-func (v AnyValidatorMap) validate(value map[string]any) error {
+func (v AnyValidatorMap) validate(ctx context.Context, value map[string]any, strict bool, coercion Coercion, order FieldOrder) error {
+	fields := make([]string, 0, len(v))
+	for field := range v {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
 	var merr []*ErrObjectField
-	for field, validator := range v {
+	for _, field := range fields {
+		validator, presence := keyPolicy(v[field])
 		fieldValue, exists := value[field]
-		if !exists {
-			return fmt.Errorf("search %s field: %w", field, ErrNotExistsField)
-		}
-		vv := reflect.TypeOf(validator)
-		vFunc, exist := vv.MethodByName("Validate")
-		if !exist {
-			panic(fmt.Sprintf("%s does not implement Validate", field))
+		switch {
+		case !exists && presence&KeyOptional != 0:
+			continue
+		case !exists:
+			merr = append(merr, newErrObjectField(field, ErrNotExistsField))
+			continue
+		case fieldValue == nil && presence&KeyNullable != 0:
+			continue
+		case fieldValue == nil:
+			merr = append(merr, newErrObjectField(field, ErrEmpty))
+			continue
 		}
-
-		fieldType := reflect.TypeOf(fieldValue)
-		if arg := vFunc.Type.In(1); fieldType != arg {
-			return fmt.Errorf("input %s type, but expected %s: %w", fieldType, arg, ErrInvalidType)
+		fieldValue, err := coerceValue(fieldValue, validateArgType(validator), coercion)
+		if err != nil {
+			merr = append(merr, newErrObjectField(field, err))
+			continue
 		}
 
-		if err := validator.validateAny(fieldValue); err != nil {
+		if err := validator.validateAny(withField(ctx, field), fieldValue); isAbort(err) {
+			return err
+		} else if err != nil {
 			merr = append(merr, newErrObjectField(field, err))
 		}
 	}
+	if strict {
+		var unknown []string
+		for field := range value {
+			if _, exists := v[field]; !exists {
+				unknown = append(unknown, field)
+			}
+		}
+		sort.Strings(unknown)
+		for _, field := range unknown {
+			merr = append(merr, newErrObjectField(field, ErrUnknownField))
+		}
+	}
+	order.sort(merr, nil)
 	return newErrObject(merr...)
 }
+
+// validateArgType returns the argument type of Validate method of validator.
+func validateArgType(validator AnyValidator) reflect.Type {
+	vFunc, exist := reflect.TypeOf(validator).MethodByName("Validate")
+	if !exist {
+		panic(fmt.Sprintf("%T does not implement Validate", validator))
+	}
+	return vFunc.Type.In(1)
+}
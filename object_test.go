@@ -1,8 +1,11 @@
 package svalidator_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/komem3/svalidator"
 )
@@ -60,6 +63,13 @@ func TestSafeObject_Error(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"key of map",
+			svalidator.ValidatorMap[Sample]{
+				"TestString1": svalidator.Key(svalidator.String().Required(), svalidator.KeyOptional),
+			},
+			true,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			_, err := svalidator.SafeObject(tt.arg)
@@ -203,7 +213,12 @@ func TestMap(t *testing.T) {
 					"ID": "test",
 				},
 			},
-			svalidator.ErrNotExistsField,
+			svalidator.ErrObject{
+				&svalidator.ErrObjectField{
+					Field: "Name",
+					Err:   svalidator.ErrNotExistsField,
+				},
+			},
 		},
 		{
 			"invalid type",
@@ -215,7 +230,12 @@ func TestMap(t *testing.T) {
 					"ID": "test",
 				},
 			},
-			svalidator.ErrInvalidType,
+			svalidator.ErrObject{
+				&svalidator.ErrObjectField{
+					Field: "ID",
+					Err:   svalidator.ErrInvalidType,
+				},
+			},
 		},
 		{
 			"validation error",
@@ -242,3 +262,152 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func TestMap_Presence(t *testing.T) {
+	fieldErr := func(field string, err error) error {
+		return svalidator.ErrObject{&svalidator.ErrObjectField{Field: field, Err: err}}
+	}
+	for _, tt := range []struct {
+		name      string
+		validator *svalidator.MapValidator
+		input     map[string]any
+		want      error
+	}{
+		{
+			"optional key",
+			svalidator.Map(svalidator.AnyValidatorMap{"Name": svalidator.Key(svalidator.String().Required(), svalidator.KeyOptional)}),
+			map[string]any{},
+			nil,
+		},
+		{
+			"optional key is validated",
+			svalidator.Map(svalidator.AnyValidatorMap{"Name": svalidator.Key(svalidator.String().Required(), svalidator.KeyOptional)}),
+			map[string]any{"Name": ""},
+			fieldErr("Name", svalidator.ErrEmpty),
+		},
+		{
+			"null value",
+			svalidator.Map(svalidator.AnyValidatorMap{"Name": svalidator.Key(svalidator.String(), svalidator.KeyOptional)}),
+			map[string]any{"Name": nil},
+			fieldErr("Name", svalidator.ErrEmpty),
+		},
+		{
+			"nullable key",
+			svalidator.Map(svalidator.AnyValidatorMap{"Name": svalidator.Key(svalidator.String().Required(), svalidator.KeyNullable)}),
+			map[string]any{"Name": nil},
+			nil,
+		},
+		{
+			"nullable key must exist",
+			svalidator.Map(svalidator.AnyValidatorMap{"Name": svalidator.Key(svalidator.String(), svalidator.KeyNullable)}),
+			map[string]any{},
+			fieldErr("Name", svalidator.ErrNotExistsField),
+		},
+		{
+			"optional and nullable key",
+			svalidator.Map(svalidator.AnyValidatorMap{
+				"Name": svalidator.Key(svalidator.String(), svalidator.KeyOptional|svalidator.KeyNullable),
+				"Age":  svalidator.Key(svalidator.Number[int](), svalidator.KeyOptional|svalidator.KeyNullable),
+			}),
+			map[string]any{"Name": nil},
+			nil,
+		},
+		{
+			"unknown key",
+			svalidator.Map(svalidator.AnyValidatorMap{"ID": svalidator.Number[int]()}),
+			map[string]any{"ID": 1, "Extra": 1},
+			nil,
+		},
+		{
+			"strict",
+			svalidator.Map(svalidator.AnyValidatorMap{"ID": svalidator.Number[int]()}).Strict(),
+			map[string]any{"ID": 1, "Extra": 1},
+			fieldErr("Extra", svalidator.ErrUnknownField),
+		},
+		{
+			"all errors",
+			svalidator.Map(svalidator.AnyValidatorMap{"ID": svalidator.Number[int]().Min(1)}).Strict(),
+			map[string]any{"ID": 0, "Extra": 1},
+			svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "ID", Err: svalidator.ErrTooSmall},
+				&svalidator.ErrObjectField{Field: "Extra", Err: svalidator.ErrUnknownField},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestMap_Coerce(t *testing.T) {
+	var body map[string]any
+	dec := json.NewDecoder(strings.NewReader(`{"ID": 10, "Ratio": 0.5, "Count": 1.5, "Small": 300, "At": "2024-06-01T09:00:00+09:00", "Parent": 1}`))
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	var floatBody map[string]any
+	if err := json.Unmarshal([]byte(`{"ID": 10, "Ratio": 0.5, "Count": 1.5, "Small": 300, "At": "bad", "Parent": 1}`), &floatBody); err != nil {
+		t.Fatal(err)
+	}
+	validator := func() *svalidator.MapValidator {
+		return svalidator.Map(svalidator.AnyValidatorMap{
+			"ID":     svalidator.Number[int]().Min(1),
+			"Ratio":  svalidator.Number[float32]().Max(1),
+			"Count":  svalidator.Number[int](),
+			"Small":  svalidator.Number[int8](),
+			"At":     svalidator.Time().After(func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }),
+			"Parent": svalidator.Optional[uint](svalidator.Number[uint]().Min(1)),
+		})
+	}
+	for _, tt := range []struct {
+		name      string
+		validator *svalidator.MapValidator
+		input     map[string]any
+		want      error
+	}{
+		{
+			"no coercion",
+			validator(),
+			map[string]any{"ID": 10, "Ratio": float32(0.5), "Count": 1, "Small": int8(1), "At": time.Now(), "Parent": pointer[uint](1)},
+			nil,
+		},
+		{
+			"strict json number",
+			validator().Coerce(svalidator.CoerceStrict),
+			body,
+			svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "Count", Err: svalidator.ErrFractional},
+				&svalidator.ErrObjectField{Field: "Small", Err: svalidator.ErrNumberOverflow},
+			},
+		},
+		{
+			"lenient float",
+			validator().Coerce(svalidator.CoerceLenient),
+			floatBody,
+			svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "Small", Err: svalidator.ErrNumberOverflow},
+				&svalidator.ErrObjectField{Field: "At", Err: svalidator.ErrInvalidTime},
+			},
+		},
+		{
+			"rule after coercion",
+			svalidator.Map(svalidator.AnyValidatorMap{"ID": svalidator.Number[int]().Min(1)}).Coerce(svalidator.CoerceStrict),
+			map[string]any{"ID": float64(0)},
+			svalidator.ErrObject{&svalidator.ErrObjectField{Field: "ID", Err: svalidator.ErrTooSmall}},
+		},
+		{
+			"string is not coerced",
+			svalidator.Map(svalidator.AnyValidatorMap{"ID": svalidator.Number[int]()}).Coerce(svalidator.CoerceLenient),
+			map[string]any{"ID": "1"},
+			svalidator.ErrObject{&svalidator.ErrObjectField{Field: "ID", Err: svalidator.ErrInvalidType}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validator.Validate(tt.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package svalidator
+
+import (
+	"reflect"
+	"sort"
+)
+
+// FieldOrder is the order of fields in ErrObject.
+type FieldOrder struct {
+	alphabetical bool
+	keys         []string
+}
+
+var (
+	// StructFieldOrder orders fields as declared in the struct. This is the default.
+	// MapValidator, which has no struct, orders fields alphabetically.
+	StructFieldOrder = FieldOrder{}
+	// AlphabeticalOrder orders fields by name.
+	AlphabeticalOrder = FieldOrder{alphabetical: true}
+)
+
+// DeclarationOrder orders fields as keys.
+// Fields which are not in keys follow them in the default order.
+func DeclarationOrder(keys ...string) FieldOrder {
+	return FieldOrder{keys: keys}
+}
+
+// Order sets the order of fields in ErrObject.
+func (o *ObjectValidator[T]) Order(order FieldOrder) *ObjectValidator[T] {
+	o.order = order
+	return o
+}
+
+// Order sets the order of fields in ErrObject.
+func (m *MapValidator) Order(order FieldOrder) *MapValidator {
+	m.order = order
+	return m
+}
+
+// sort sorts errs in o. If structType is nil, the default order is alphabetical.
+func (o FieldOrder) sort(errs []*ErrObjectField, structType reflect.Type) {
+	declared := make(map[string]int, len(o.keys))
+	for i, k := range o.keys {
+		if _, dup := declared[k]; !dup {
+			declared[k] = i
+		}
+	}
+	rank := func(field string) (int, int) {
+		first := len(o.keys)
+		if i, ok := declared[field]; ok {
+			first = i
+		}
+		if o.alphabetical || structType == nil {
+			return first, 0
+		}
+		if f, ok := structType.FieldByName(field); ok {
+			return first, f.Index[0]
+		}
+		return first, structType.NumField()
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		fi, si := rank(errs[i].Field)
+		fj, sj := rank(errs[j].Field)
+		if fi != fj {
+			return fi < fj
+		}
+		if si != sj {
+			return si < sj
+		}
+		return (o.alphabetical || structType == nil) && errs[i].Field < errs[j].Field
+	})
+}
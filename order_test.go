@@ -0,0 +1,106 @@
+package svalidator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestOrder(t *testing.T) {
+	type Sample struct {
+		Zeta  string
+		Alpha string
+		Mid   string
+	}
+	objectMap := func() svalidator.ValidatorMap[Sample] {
+		return svalidator.ValidatorMap[Sample]{
+			"Zeta":  svalidator.String().Required(),
+			"Alpha": svalidator.String().Required(),
+			"Mid":   svalidator.String().Required(),
+		}
+	}
+	anyMap := func() svalidator.AnyValidatorMap {
+		return svalidator.AnyValidatorMap{
+			"Zeta":  svalidator.String().Required(),
+			"Alpha": svalidator.String().Required(),
+			"Mid":   svalidator.String().Required(),
+		}
+	}
+	input := map[string]any{"Zeta": "", "Alpha": "", "Mid": "", "Extra": ""}
+	for _, tt := range []struct {
+		name     string
+		validate func() error
+		want     []string
+	}{
+		{"struct field order", func() error { return svalidator.Object(objectMap()).Validate(Sample{}) }, []string{"Zeta", "Alpha", "Mid"}},
+		{"alphabetical object", func() error {
+			return svalidator.Object(objectMap()).Order(svalidator.AlphabeticalOrder).Validate(Sample{})
+		}, []string{"Alpha", "Mid", "Zeta"}},
+		{"declaration object", func() error {
+			return svalidator.Object(objectMap()).Order(svalidator.DeclarationOrder("Mid")).Validate(Sample{})
+		}, []string{"Mid", "Zeta", "Alpha"}},
+		{"map default", func() error { return svalidator.Map(anyMap()).Strict().Validate(input) }, []string{"Alpha", "Extra", "Mid", "Zeta"}},
+		{"declaration map", func() error {
+			return svalidator.Map(anyMap()).Strict().Order(svalidator.DeclarationOrder("Zeta", "Mid", "Alpha")).Validate(input)
+		}, []string{"Zeta", "Mid", "Alpha", "Extra"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// repeat to catch map iteration order.
+			for i := 0; i < 20; i++ {
+				var errs svalidator.ErrObject
+				if !errors.As(tt.validate(), &errs) {
+					t.Fatal("error is not ErrObject")
+				}
+				var got []string
+				for _, f := range errs {
+					got = append(got, f.Field)
+				}
+				if len(got) != len(tt.want) {
+					t.Fatalf("want %v, got %v", tt.want, got)
+				}
+				for i := range got {
+					if got[i] != tt.want[i] {
+						t.Fatalf("want %v, got %v", tt.want, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestErrObject_Is(t *testing.T) {
+	err := svalidator.ErrObject{
+		&svalidator.ErrObjectField{Field: "A", Err: svalidator.ErrEmpty},
+		&svalidator.ErrObjectField{Field: "B", Err: svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "C", Err: svalidator.ErrTooBig},
+			&svalidator.ErrObjectField{Field: "D", Err: svalidator.ErrTooSmall},
+		}},
+	}
+	for _, tt := range []struct {
+		name   string
+		target error
+		want   bool
+	}{
+		{"same", err, true},
+		{"reordered", svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "B", Err: svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "D", Err: svalidator.ErrTooSmall},
+			}},
+			&svalidator.ErrObjectField{Field: "A", Err: svalidator.ErrEmpty},
+		}, true},
+		{"other error", svalidator.ErrObject{&svalidator.ErrObjectField{Field: "A", Err: svalidator.ErrTooBig}}, false},
+		{"other field", svalidator.ErrObject{&svalidator.ErrObjectField{Field: "E", Err: svalidator.ErrEmpty}}, false},
+		{"nested other error", svalidator.ErrObject{&svalidator.ErrObjectField{Field: "B", Err: svalidator.ErrObject{
+			&svalidator.ErrObjectField{Field: "C", Err: svalidator.ErrTooSmall},
+		}}}, false},
+		{"field error", svalidator.ErrTooSmall, true},
+		{"other field error", svalidator.ErrNotFound, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(err, tt.target); got != tt.want {
+				t.Errorf("want %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
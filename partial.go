@@ -0,0 +1,172 @@
+package svalidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldMask is a set of fields to validate by name of struct field.
+// A nil FieldMask of a field means the whole field including nested fields.
+type FieldMask map[string]FieldMask
+
+// NewFieldMask returns FieldMask of dot separated paths such as "Name" and "Address.City".
+func NewFieldMask(paths ...string) FieldMask {
+	mask := FieldMask{}
+	for _, path := range paths {
+		m := mask
+		segments := strings.Split(path, ".")
+		for i, s := range segments {
+			sub, exists := m[s]
+			if exists && sub == nil {
+				// the whole field is already included.
+				break
+			}
+			if i == len(segments)-1 {
+				m[s] = nil
+				break
+			}
+			if sub == nil {
+				sub = FieldMask{}
+				m[s] = sub
+			}
+			m = sub
+		}
+	}
+	return mask
+}
+
+// FieldMaskOf returns FieldMask of the keys present in input, such as a JSON body of a PATCH request.
+// input is a map[string]any decoded from JSON, or JSON bytes of an object.
+// Keys are matched to the fields of T as Decode, and nested objects are masked recursively.
+// T must be a struct or a pointer to struct.
+func FieldMaskOf[T any, I map[string]any | []byte](input I) (FieldMask, error) {
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("only allow struct, but input %s", rt)
+	}
+
+	var m map[string]any
+	switch in := any(input).(type) {
+	case map[string]any:
+		m = in
+	case []byte:
+		dec := json.NewDecoder(bytes.NewReader(in))
+		dec.UseNumber()
+		if err := dec.Decode(&m); err != nil {
+			return nil, err
+		}
+	}
+	return fieldMaskOf(rt, m), nil
+}
+
+// fieldMaskOf returns FieldMask of the keys of m. Promoted fields are masked under their embedded fields.
+func fieldMaskOf(rt reflect.Type, m map[string]any) FieldMask {
+	mask := FieldMask{}
+	for _, field := range jsonFields(rt) {
+		raw, exists := lookupKey(m, field.name)
+		if !exists {
+			continue
+		}
+		var sub FieldMask
+		ft := rt.FieldByIndex(field.index).Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if nested, ok := raw.(map[string]any); ok && ft.Kind() == reflect.Struct && !reflect.PointerTo(ft).Implements(textUnmarshalerType) {
+			sub = fieldMaskOf(ft, nested)
+		}
+
+		parent := mask
+		for _, name := range field.path[:len(field.path)-1] {
+			if parent[name] == nil {
+				parent[name] = FieldMask{}
+			}
+			parent = parent[name]
+		}
+		parent[field.path[len(field.path)-1]] = sub
+	}
+	return mask
+}
+
+// ValidateFields validates only the fields at paths, which are dot separated such as "Address.City".
+func (o *ObjectValidator[T]) ValidateFields(value T, paths ...string) error {
+	return o.ValidatePartial(value, NewFieldMask(paths...))
+}
+
+// ValidatePartial validates only the fields in mask.
+// Elements of a slice are masked by the FieldMask of the slice field.
+// Paths of mask which are not fields of T fail with ErrNotExistsField.
+func (o *ObjectValidator[T]) ValidatePartial(value T, mask FieldMask) error {
+	return o.ValidatePartialContext(context.Background(), value, mask)
+}
+
+// ValidatePartialContext is ValidatePartial with ctx.
+// Pass ctx of WithGroups to validate the fields with validation groups.
+func (o *ObjectValidator[T]) ValidatePartialContext(ctx context.Context, value T, mask FieldMask) error {
+	if mask == nil {
+		mask = FieldMask{}
+	}
+	var zero T
+	if errs := unknownMaskFields(reflect.TypeOf(zero), mask, ""); len(errs) > 0 {
+		return Merge(errs...)
+	}
+	return o.ValidateContext(withMask(ctx, mask), value)
+}
+
+// unknownMaskFields returns ErrNotExistsField for each path of mask which is not a field of rt.
+func unknownMaskFields(rt reflect.Type, mask FieldMask, prefix string) []error {
+	for rt.Kind() == reflect.Pointer || rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array {
+		rt = rt.Elem()
+	}
+	names := make([]string, 0, len(mask))
+	for name := range mask {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		path := prefix + name
+		var field reflect.StructField
+		exists := rt.Kind() == reflect.Struct
+		if exists {
+			field, exists = rt.FieldByName(name)
+			exists = exists && len(field.Index) == 1
+		}
+		if !exists {
+			errs = append(errs, Prefix(path, ErrNotExistsField))
+			continue
+		}
+		if sub := mask[name]; sub != nil {
+			errs = append(errs, unknownMaskFields(field.Type, sub, path+".")...)
+		}
+	}
+	return errs
+}
+
+type fieldMaskKey struct{}
+
+func withMask(ctx context.Context, mask FieldMask) context.Context {
+	if current, _ := ctx.Value(fieldMaskKey{}).(FieldMask); current == nil && mask == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, fieldMaskKey{}, mask)
+}
+
+// maskedField returns ctx for validating field, and reports whether field is in the mask of ctx.
+func maskedField(ctx context.Context, field string) (context.Context, bool) {
+	mask, _ := ctx.Value(fieldMaskKey{}).(FieldMask)
+	if mask == nil {
+		return ctx, true
+	}
+	sub, ok := mask[field]
+	return withMask(ctx, sub), ok
+}
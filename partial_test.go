@@ -0,0 +1,228 @@
+package svalidator_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+type partialAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type partialItem struct {
+	Name string
+	Note string
+}
+
+type partialUser struct {
+	Name    string          `json:"name"`
+	Email   string          `json:"email"`
+	Address partialAddress  `json:"address"`
+	Backup  *partialAddress `json:"backup"`
+	Items   []partialItem   `json:"items"`
+	Ignored string          `json:"-"`
+}
+
+func partialUserValidator() *svalidator.ObjectValidator[partialUser] {
+	address := svalidator.Object(svalidator.ValidatorMap[partialAddress]{
+		"City": svalidator.String().Required(),
+		"Zip":  svalidator.String().Required(),
+	})
+	return svalidator.Object(svalidator.ValidatorMap[partialUser]{
+		"Name":    svalidator.String().Required(),
+		"Email":   svalidator.String().Required(),
+		"Address": address,
+		"Backup":  svalidator.Optional[partialAddress](address),
+		"Items": svalidator.Slice[partialItem](svalidator.Object(svalidator.ValidatorMap[partialItem]{
+			"Name": svalidator.String().Required(),
+			"Note": svalidator.String().Required(),
+		})),
+	})
+}
+
+func TestObject_ValidatePartial(t *testing.T) {
+	input := partialUser{
+		Backup: &partialAddress{},
+		Items:  []partialItem{{}},
+	}
+	for _, tt := range []struct {
+		name  string
+		paths []string
+		want  []string
+	}{
+		{"empty mask", nil, nil},
+		{"top field", []string{"Name"}, []string{"Name"}},
+		{"whole nested field", []string{"Address"}, []string{"Address.City", "Address.Zip"}},
+		{"nested field", []string{"Address.City", "Email"}, []string{"Email", "Address.City"}},
+		{"whole and nested field", []string{"Address", "Address.City"}, []string{"Address.City", "Address.Zip"}},
+		{"pointer field", []string{"Backup.Zip"}, []string{"Backup.Zip"}},
+		{"slice elements", []string{"Items.Note"}, []string{"Items.0.Note"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := partialUserValidator().ValidateFields(input, tt.paths...)
+			var got []string
+			var errs svalidator.ErrObject
+			if errors.As(err, &errs) {
+				got = errs.Fields()
+			} else if err != nil {
+				t.Fatalf("error is not ErrObject: %v", err)
+			}
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestObject_ValidatePartialUnknown(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		paths []string
+		want  []string
+	}{
+		{"top field", []string{"Nmae"}, []string{"Nmae"}},
+		{"nested field", []string{"Name", "Address.Cty", "Backup.Zip"}, []string{"Address.Cty"}},
+		{"slice element field", []string{"Items.Nme"}, []string{"Items.Nme"}},
+		{"field of string", []string{"Name.First"}, []string{"Name.First"}},
+		{"several fields", []string{"B", "A"}, []string{"A", "B"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := partialUserValidator().ValidateFields(partialUser{}, tt.paths...)
+			var errs svalidator.ErrObject
+			if !errors.As(err, &errs) || !reflect.DeepEqual(tt.want, errs.Fields()) {
+				t.Fatalf("want %v, got %v", tt.want, err)
+			}
+			for _, path := range tt.want {
+				if !errs.HasCode(path, svalidator.ErrNotExistsField) {
+					t.Errorf("want ErrNotExistsField of %s, got %v", path, errs.For(path))
+				}
+			}
+		})
+	}
+}
+
+func TestObject_ValidatePartialAll(t *testing.T) {
+	// the mask is not kept after partial validation.
+	v := partialUserValidator()
+	assertError(t, nil, v.ValidateFields(partialUser{}, "Backup"))
+	if err := v.Validate(partialUser{}); err == nil {
+		t.Error("want error of full validation, got nil")
+	}
+}
+
+func TestFieldMaskOf(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		input string
+		want  svalidator.FieldMask
+	}{
+		{"empty", `{}`, svalidator.FieldMask{}},
+		{"top fields", `{"name":"a","EMAIL":"b","unknown":1,"Ignored":""}`, svalidator.FieldMask{"Name": nil, "Email": nil}},
+		{"nested object", `{"address":{"city":"x"},"backup":{"zip":"1"}}`, svalidator.FieldMask{
+			"Address": {"City": nil},
+			"Backup":  {"Zip": nil},
+		}},
+		{"null object", `{"backup":null}`, svalidator.FieldMask{"Backup": nil}},
+		{"array", `{"items":[{"note":"x"}]}`, svalidator.FieldMask{"Items": nil}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svalidator.FieldMaskOf[partialUser]([]byte(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := svalidator.FieldMaskOf[partialUser]([]byte(`[1]`)); err == nil {
+		t.Error("want error of not object, got nil")
+	}
+	if _, err := svalidator.FieldMaskOf[string]([]byte(`{}`)); err == nil {
+		t.Error("want error of not struct, got nil")
+	}
+	got, err := svalidator.FieldMaskOf[*partialUser]([]byte(`{"name":"a"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (svalidator.FieldMask{"Name": nil}); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	type Embedded struct {
+		decodeBase
+		Name string `json:"name"`
+	}
+	got, err = svalidator.FieldMaskOf[Embedded]([]byte(`{"id":1,"name":"a"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (svalidator.FieldMask{"decodeBase": {"ID": nil}, "Name": nil}); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	got, err = svalidator.FieldMaskOf[partialUser](map[string]any{"address": map[string]any{"zip": "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (svalidator.FieldMask{"Address": {"Zip": nil}}); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	// PATCH body with the derived mask.
+	body := []byte(`{"address":{"city":""}}`)
+	mask, err := svalidator.FieldMaskOf[partialUser](body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = partialUserValidator().ValidatePartial(partialUser{}, mask)
+	var errs svalidator.ErrObject
+	if !errors.As(err, &errs) || !reflect.DeepEqual([]string{"Address.City"}, errs.Fields()) {
+		t.Errorf("want error of Address.City, got %v", err)
+	}
+}
+
+func TestObject_ValidatePartialLookup(t *testing.T) {
+	type Item struct {
+		CategoryID string
+	}
+	type Product struct {
+		Name       string
+		OwnerID    string
+		CategoryID string
+		Items      []Item
+	}
+	var (
+		mu    sync.Mutex
+		calls [][]string
+	)
+	exists := svalidator.Lookup(func(ctx context.Context, keys []string) ([]error, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, keys)
+		return make([]error, len(keys)), nil
+	})
+	v := svalidator.Object(svalidator.ValidatorMap[Product]{
+		"Name":       svalidator.String().Required(),
+		"OwnerID":    exists,
+		"CategoryID": exists,
+		"Items": svalidator.Slice[Item](svalidator.Object(svalidator.ValidatorMap[Item]{
+			"CategoryID": exists,
+		})),
+	})
+
+	assertError(t, nil, v.ValidateFields(Product{Name: "a"}, "Name"))
+	if len(calls) != 0 {
+		t.Errorf("want no call, but got: %v", calls)
+	}
+	assertError(t, nil, v.ValidateFields(Product{CategoryID: "c", Items: []Item{{CategoryID: "i"}}}, "CategoryID", "Items.CategoryID"))
+	if len(calls) != 1 || !reflect.DeepEqual([]string{"c", "i"}, calls[0]) && !reflect.DeepEqual([]string{"i", "c"}, calls[0]) {
+		t.Errorf("want 1 call of c and i, but got: %v", calls)
+	}
+}
@@ -0,0 +1,39 @@
+package svalidator
+
+import "context"
+
+// Presence is a policy for the presence of a key in MapValidator.
+// Policies can be combined, such as KeyOptional | KeyNullable.
+type Presence uint8
+
+const (
+	// KeyRequired requires the key with a non-null value. This is the default.
+	KeyRequired Presence = 0
+	// KeyOptional allows the key to be absent.
+	KeyOptional Presence = 1 << iota
+	// KeyNullable allows the value to be null, which is not validated.
+	KeyNullable
+)
+
+// Key sets presence policy to the validator of an AnyValidatorMap entry.
+// It is for MapValidator and Array, and SafeObject returns an error for it in ValidatorMap.
+func Key(validator AnyValidator, presence Presence) AnyValidator {
+	return &keyValidator{AnyValidator: validator, presence: presence}
+}
+
+type keyValidator struct {
+	AnyValidator
+	presence Presence
+}
+
+func (k *keyValidator) validateAny(ctx context.Context, v any) error {
+	return k.AnyValidator.validateAny(ctx, v)
+}
+
+// keyPolicy returns the validator and the presence policy of an AnyValidatorMap entry.
+func keyPolicy(validator AnyValidator) (AnyValidator, Presence) {
+	if k, ok := validator.(*keyValidator); ok {
+		return k.AnyValidator, k.presence
+	}
+	return validator, KeyRequired
+}
@@ -0,0 +1,118 @@
+package svalidator
+
+import (
+	"context"
+)
+
+// NilPolicy decides how OptionalValidator treats nil input.
+type NilPolicy int
+
+const (
+	// NilSkip passes nil input without running the inner validator.
+	NilSkip NilPolicy = iota
+	// NilRequired rejects nil input with ErrEmpty.
+	NilRequired
+	// NilForbidden rejects non-nil input with ErrNotEmpty.
+	NilForbidden
+)
+
+func (p NilPolicy) check(isNil bool) error {
+	switch {
+	case isNil && p == NilRequired:
+		return ErrEmpty
+	case !isNil && p == NilForbidden:
+		return ErrNotEmpty
+	}
+	return nil
+}
+
+// unwrapValidate strips ErrValidate returned by an inner validator,
+// so that the outer validator reports its own input.
+func unwrapValidate(err error) error {
+	// only the outermost ErrValidate is stripped, since errors.As would find one in the fields of ErrObject.
+	if verr, ok := err.(*ErrValidate); ok {
+		return verr.Err
+	}
+	return err
+}
+
+// ValueValidator is implemented by every validator of this package.
+type ValueValidator[T any] interface {
+	Validate(value T) error
+}
+
+// OptionalValidator lifts a validator for T to *T.
+type OptionalValidator[T any] struct {
+	*Validator[*T]
+	inner  ValueValidator[T]
+	policy NilPolicy
+}
+
+// Optional returns OptionalValidator which validates the pointed value by inner.
+// By default, nil input passes.
+func Optional[T any](inner ValueValidator[T]) *OptionalValidator[T] {
+	o := &OptionalValidator[T]{inner: inner}
+	o.Validator = NewContext(func(ctx context.Context, value *T) error {
+		if value == nil {
+			return o.policy.check(true)
+		}
+		if err := o.policy.check(false); err != nil {
+			return err
+		}
+		return unwrapValidate(validateWith(ctx, inner, *value))
+	})
+	return o
+}
+
+// Ptr is an alias of Optional.
+func Ptr[T any](inner ValueValidator[T]) *OptionalValidator[T] {
+	return Optional(inner)
+}
+
+// Nil sets the policy for nil input.
+func (o *OptionalValidator[T]) Nil(policy NilPolicy) *OptionalValidator[T] {
+	o.policy = policy
+	return o
+}
+
+// Required rejects nil input.
+func (o *OptionalValidator[T]) Required() *OptionalValidator[T] {
+	return o.Nil(NilRequired)
+}
+
+// Forbidden rejects non-nil input.
+func (o *OptionalValidator[T]) Forbidden() *OptionalValidator[T] {
+	return o.Nil(NilForbidden)
+}
+
+func (o *OptionalValidator[T]) AppendValidate(funcs ...Validate[*T]) *OptionalValidator[T] {
+	o.Validator = o.Validator.AppendValidate(funcs...)
+	return o
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (o *OptionalValidator[T]) AppendValidator(inner ValueValidator[*T]) *OptionalValidator[T] {
+	o.Validator = o.Validator.AppendValidator(inner)
+	return o
+}
+
+func (o *OptionalValidator[T]) hasLookup() bool {
+	c, ok := o.inner.(lookupCollector)
+	return ok && c.hasLookup()
+}
+
+func (o *OptionalValidator[T]) collectLookup(ctx context.Context, run *lookupRun, value any) {
+	if v := value.(*T); v != nil && o.policy != NilForbidden {
+		o.inner.(lookupCollector).collectLookup(ctx, run, *v)
+	}
+}
+
+// skipNil lifts f to a Validate func for pointer which passes nil.
+func skipNil[T any](f Validate[T]) Validate[*T] {
+	return func(value *T) error {
+		if value == nil {
+			return nil
+		}
+		return f(*value)
+	}
+}
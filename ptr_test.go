@@ -0,0 +1,112 @@
+package svalidator_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestOptional_Validate(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.OptionalValidator[string]
+			input     *string
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{
+			"pass",
+			args{
+				validator: svalidator.Optional[string](svalidator.String().Max(3)),
+				input:     pointer("abc"),
+			},
+			nil,
+		},
+		{
+			"inner error",
+			args{
+				validator: svalidator.Optional[string](svalidator.String().Max(3)),
+				input:     pointer("abcd"),
+			},
+			svalidator.ErrTooBig,
+		},
+		{
+			"skip nil",
+			args{
+				validator: svalidator.Optional[string](svalidator.String().Required()),
+				input:     nil,
+			},
+			nil,
+		},
+		{
+			"nil is required error",
+			args{
+				validator: svalidator.Optional[string](svalidator.String()).Required(),
+				input:     nil,
+			},
+			svalidator.ErrEmpty,
+		},
+		{
+			"pass forbidden",
+			args{
+				validator: svalidator.Ptr[string](svalidator.String()).Forbidden(),
+				input:     nil,
+			},
+			nil,
+		},
+		{
+			"forbidden error",
+			args{
+				validator: svalidator.Ptr[string](svalidator.String()).Forbidden(),
+				input:     pointer(""),
+			},
+			svalidator.ErrNotEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestOptional_Input(t *testing.T) {
+	input := pointer(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	err := svalidator.Optional[time.Time](svalidator.Time().Before(func() time.Time {
+		return time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	})).Validate(input)
+
+	var verr *svalidator.ErrValidate
+	if !errors.As(err, &verr) {
+		t.Fatalf("want ErrValidate, but got: %v", err)
+	}
+	if verr.Input != any(input) {
+		t.Errorf("want input: %v.\nbut got: %v", input, verr.Input)
+	}
+	if _, ok := verr.Err.(*svalidator.ErrValidate); ok {
+		t.Errorf("inner error is wrapped twice: %v", verr.Err)
+	}
+}
+
+func TestOptional_Object(t *testing.T) {
+	type Sample struct {
+		Name *string
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[Sample]{
+		"Name": svalidator.Optional[string](svalidator.String().Max(3)),
+	})
+	assertError(t, nil, v.Validate(Sample{}))
+	assertError(t, svalidator.ErrObject{
+		&svalidator.ErrObjectField{
+			Field: "Name",
+			Err:   svalidator.ErrTooBig,
+		},
+	}, v.Validate(Sample{Name: pointer("abcd")}))
+}
@@ -0,0 +1,177 @@
+package svalidator
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// normalizer is implemented by validators which transform input before validation.
+type normalizer interface {
+	normalizeAny(value any) any
+}
+
+// Trim adds a transform which removes leading and trailing white space.
+func (s *UStringValidator[T]) Trim() *UStringValidator[T] {
+	return s.Transform(func(value T) T {
+		return T(strings.TrimSpace(string(value)))
+	})
+}
+
+// ToLower adds a transform which maps letters to lower case.
+func (s *UStringValidator[T]) ToLower() *UStringValidator[T] {
+	return s.Transform(func(value T) T {
+		return T(strings.ToLower(string(value)))
+	})
+}
+
+// ToUpper adds a transform which maps letters to upper case.
+func (s *UStringValidator[T]) ToUpper() *UStringValidator[T] {
+	return s.Transform(func(value T) T {
+		return T(strings.ToUpper(string(value)))
+	})
+}
+
+// FoldWidth adds a transform which maps full width ASCII characters and the ideographic space to half width.
+func (s *UStringValidator[T]) FoldWidth() *UStringValidator[T] {
+	return s.Transform(func(value T) T {
+		return T(strings.Map(foldWidth, string(value)))
+	})
+}
+
+// CollapseSpaces adds a transform which replaces each run of white space with a single space.
+func (s *UStringValidator[T]) CollapseSpaces() *UStringValidator[T] {
+	return s.Transform(func(value T) T {
+		return T(collapseSpaces(string(value)))
+	})
+}
+
+// Transform adds a custom transform.
+// Transforms run in the order they are added, before every validate regardless of the position in the chain.
+// f should be idempotent, because a sanitized value may be transformed again when it is validated.
+func (s *UStringValidator[T]) Transform(f func(T) T) *UStringValidator[T] {
+	s.transforms = append(s.transforms, f)
+	return s
+}
+
+// Validate validates input after applying the transforms.
+func (s *UStringValidator[T]) Validate(value T) error {
+	return s.ValidateContext(context.Background(), value)
+}
+
+// ValidateContext validates input with ctx after applying the transforms.
+func (s *UStringValidator[T]) ValidateContext(ctx context.Context, value T) error {
+	return s.Validator.ValidateContext(ctx, s.normalize(value))
+}
+
+// Clean returns input after applying the transforms and the validation error of it.
+func (s *UStringValidator[T]) Clean(value T) (T, error) {
+	return s.CleanContext(context.Background(), value)
+}
+
+// CleanContext returns input after applying the transforms and the validation error of it with ctx.
+func (s *UStringValidator[T]) CleanContext(ctx context.Context, value T) (T, error) {
+	value = s.normalize(value)
+	return value, s.Validator.ValidateContext(ctx, value)
+}
+
+func (s *UStringValidator[T]) normalize(value T) T {
+	for _, f := range s.transforms {
+		value = f(value)
+	}
+	return value
+}
+
+func (s *UStringValidator[T]) validateAny(ctx context.Context, value any) error {
+	return s.ValidateContext(ctx, value.(T))
+}
+
+func (s *UStringValidator[T]) normalizeAny(value any) any {
+	return s.normalize(value.(T))
+}
+
+// Sanitize writes the transformed fields back to value, and then validates it.
+// Fields validated by UStringValidator are transformed,
+// as well as fields validated by ObjectValidator, OptionalValidator and SliceValidator wrapping them.
+// Values behind pointers and elements of slices are transformed in place, so every alias of them sees the result.
+// If value is nil, this returns ErrEmpty.
+func (o *ObjectValidator[T]) Sanitize(value *T) error {
+	return o.SanitizeContext(context.Background(), value)
+}
+
+// SanitizeContext writes the transformed fields back to value, and then validates it with ctx.
+func (o *ObjectValidator[T]) SanitizeContext(ctx context.Context, value *T) error {
+	if value == nil {
+		return &ErrValidate{Err: ErrEmpty, Input: value}
+	}
+	o.normalizeFields(reflect.ValueOf(value).Elem())
+	return o.ValidateContext(ctx, *value)
+}
+
+func (o *ObjectValidator[T]) normalizeAny(value any) any {
+	rv := reflect.New(reflect.TypeOf(value)).Elem()
+	rv.Set(reflect.ValueOf(value))
+	o.normalizeFields(rv)
+	return rv.Interface()
+}
+
+func (o *ObjectValidator[T]) normalizeFields(rv reflect.Value) {
+	for field, validator := range o.object {
+		n, ok := validator.(normalizer)
+		if !ok {
+			continue
+		}
+		fv := rv.FieldByName(field)
+		fv.Set(reflect.ValueOf(n.normalizeAny(fv.Interface())))
+	}
+}
+
+func (o *OptionalValidator[T]) normalizeAny(value any) any {
+	v := value.(*T)
+	n, ok := o.inner.(normalizer)
+	if v == nil || !ok {
+		return v
+	}
+	*v = n.normalizeAny(*v).(T)
+	return v
+}
+
+func (s *SliceValidator[T]) normalizeAny(value any) any {
+	v := value.([]T)
+	n, ok := s.inner.(normalizer)
+	if v == nil || !ok {
+		return v
+	}
+	for i, elem := range v {
+		v[i] = n.normalizeAny(elem).(T)
+	}
+	return v
+}
+
+func foldWidth(r rune) rune {
+	switch {
+	case r == '　':
+		return ' '
+	case '！' <= r && r <= '～':
+		return r - 0xFEE0
+	}
+	return r
+}
+
+func collapseSpaces(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !space {
+				b.WriteByte(' ')
+			}
+			space = true
+			continue
+		}
+		space = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
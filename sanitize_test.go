@@ -0,0 +1,105 @@
+package svalidator_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestUString_Transform(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		validator *svalidator.StringValidator
+		input     string
+		want      string
+		err       error
+	}{
+		{"trim", svalidator.String().Trim().Required(), " \t\n", "", svalidator.ErrEmpty},
+		{"to lower", svalidator.String().ToLower().Equal("abc"), "AbC", "abc", nil},
+		{"to upper", svalidator.String().ToUpper(), "abc", "ABC", nil},
+		{"fold width", svalidator.String().FoldWidth().Email(), "ｕｓｅｒ＠ｅｘａｍｐｌｅ．ｃｏｍ", "user@example.com", nil},
+		{"fold width keeps katakana", svalidator.String().FoldWidth(), "カナ　Ａ", "カナ A", nil},
+		{"collapse spaces", svalidator.String().CollapseSpaces(), "  a \t b\n\nc ", " a b c ", nil},
+		{"trim and collapse", svalidator.String().Trim().CollapseSpaces().Max(5), "  a \t b\n\nc ", "a b c", nil},
+		{"transform before rules", svalidator.String().Max(3).Trim(), "  abc  ", "abc", nil},
+		{"custom", svalidator.String().Transform(func(s string) string { return strings.ReplaceAll(s, "-", "") }).Max(7), "123-4567", "1234567", nil},
+		{"validation error", svalidator.String().Trim().Min(4), " abc ", "abc", svalidator.ErrTooSmall},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.validator.Clean(tt.input)
+			assertError(t, tt.err, err)
+			if got != tt.want {
+				t.Errorf("want %q, got %q", tt.want, got)
+			}
+			assertError(t, tt.err, tt.validator.Validate(tt.input))
+		})
+	}
+}
+
+func TestObject_Sanitize(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type User struct {
+		Email    string
+		Nickname *string
+		Tags     []string
+		Address  Address
+		Note     string
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[User]{
+		"Email":    svalidator.String().Trim().ToLower().Email(),
+		"Nickname": svalidator.Optional[string](svalidator.String().CollapseSpaces().Max(5)),
+		"Tags":     svalidator.Slice[string](svalidator.String().Trim().Required()),
+		"Address": svalidator.Object(svalidator.ValidatorMap[Address]{
+			"City": svalidator.String().FoldWidth().Trim(),
+		}),
+		"Note": svalidator.String().Max(3),
+	})
+
+	nickname := "a   b"
+	tags := []string{" go ", "  "}
+	user := User{
+		Email:    " User@Example.COM ",
+		Nickname: &nickname,
+		Tags:     tags,
+		Address:  Address{City: "　Ｔｏｋｙｏ"},
+		Note:     " n ",
+	}
+	err := v.Sanitize(&user)
+	assertError(t, svalidator.ErrObject{
+		&svalidator.ErrObjectField{
+			Field: "Tags",
+			Err: svalidator.ErrObject{
+				&svalidator.ErrObjectField{Field: "1", Err: svalidator.ErrEmpty},
+			},
+		},
+	}, err)
+	if user.Email != "user@example.com" {
+		t.Errorf("email is %q", user.Email)
+	}
+	// pointers and slices are transformed in place.
+	if user.Nickname != &nickname || nickname != "a b" {
+		t.Errorf("nickname is %q, original is %q", *user.Nickname, nickname)
+	}
+	if &user.Tags[0] != &tags[0] || tags[0] != "go" || tags[1] != "" {
+		t.Errorf("tags are %q, original are %q", user.Tags, tags)
+	}
+	if user.Address.City != "Tokyo" {
+		t.Errorf("city is %q", user.Address.City)
+	}
+	if user.Note != " n " {
+		t.Errorf("note is %q", user.Note)
+	}
+}
+
+func TestObject_SanitizeNil(t *testing.T) {
+	type User struct {
+		Name string
+	}
+	v := svalidator.Object(svalidator.ValidatorMap[User]{
+		"Name": svalidator.String().Trim(),
+	})
+	assertError(t, svalidator.ErrEmpty, v.Sanitize(nil))
+}
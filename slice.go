@@ -0,0 +1,89 @@
+package svalidator
+
+import (
+	"context"
+	"strconv"
+)
+
+// SliceValidator is a validator for slice.
+type SliceValidator[T any] struct {
+	*Validator[[]T]
+	inner ValueValidator[T]
+}
+
+// Slice returns SliceValidator which validates each element by inner.
+// Errors of elements are returned as ErrObject whose field is the index.
+func Slice[T any](inner ValueValidator[T]) *SliceValidator[T] {
+	s := &SliceValidator[T]{inner: inner}
+	s.Validator = NewContext(func(ctx context.Context, value []T) error {
+		if inner == nil {
+			return nil
+		}
+		ctx, err := prefetchLookup(ctx, s, value)
+		if err != nil {
+			return err
+		}
+		var merr []*ErrObjectField
+		for i, elem := range value {
+			if err := validateWith(withField(ctx, strconv.Itoa(i)), inner, elem); isAbort(err) {
+				return err
+			} else if err != nil {
+				merr = append(merr, newErrObjectField(strconv.Itoa(i), err))
+			}
+		}
+		return newErrObject(merr...)
+	})
+	return s
+}
+
+// Max adds a validate whether the length of input is less than or equal to m.
+func (s *SliceValidator[T]) Max(m int) *SliceValidator[T] {
+	return s.AppendValidate(func(value []T) error {
+		if len(value) > m {
+			return ErrTooBig
+		}
+		return nil
+	})
+}
+
+// Min adds a validate whether the length of input is greater than or equal to m.
+func (s *SliceValidator[T]) Min(m int) *SliceValidator[T] {
+	return s.AppendValidate(func(value []T) error {
+		if len(value) < m {
+			return ErrTooSmall
+		}
+		return nil
+	})
+}
+
+func (s *SliceValidator[T]) Required() *SliceValidator[T] {
+	return s.AppendValidate(func(value []T) error {
+		if len(value) == 0 {
+			return ErrEmpty
+		}
+		return nil
+	})
+}
+
+func (s *SliceValidator[T]) AppendValidate(funcs ...Validate[[]T]) *SliceValidator[T] {
+	s.Validator = s.Validator.AppendValidate(funcs...)
+	return s
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (s *SliceValidator[T]) AppendValidator(inner ValueValidator[[]T]) *SliceValidator[T] {
+	s.Validator = s.Validator.AppendValidator(inner)
+	return s
+}
+
+func (s *SliceValidator[T]) hasLookup() bool {
+	c, ok := s.inner.(lookupCollector)
+	return ok && c.hasLookup()
+}
+
+func (s *SliceValidator[T]) collectLookup(ctx context.Context, run *lookupRun, value any) {
+	c := s.inner.(lookupCollector)
+	for _, elem := range value.([]T) {
+		c.collectLookup(ctx, run, elem)
+	}
+}
@@ -0,0 +1,77 @@
+package svalidator_test
+
+import (
+	"testing"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestSlice_Validate(t *testing.T) {
+	type (
+		args struct {
+			validator *svalidator.SliceValidator[string]
+			input     []string
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{
+			"pass",
+			args{
+				validator: svalidator.Slice[string](svalidator.String().Required()).Min(1).Max(2),
+				input:     []string{"a", "b"},
+			},
+			nil,
+		},
+		{
+			"element error",
+			args{
+				validator: svalidator.Slice[string](svalidator.String().Required()),
+				input:     []string{"a", "", "b", ""},
+			},
+			svalidator.ErrObject{
+				&svalidator.ErrObjectField{
+					Field: "1",
+					Err:   svalidator.ErrEmpty,
+				},
+				&svalidator.ErrObjectField{
+					Field: "3",
+					Err:   svalidator.ErrEmpty,
+				},
+			},
+		},
+		{
+			"too big",
+			args{
+				validator: svalidator.Slice[string](nil).Max(1),
+				input:     []string{"a", "b"},
+			},
+			svalidator.ErrTooBig,
+		},
+		{
+			"too small",
+			args{
+				validator: svalidator.Slice[string](nil).Min(3),
+				input:     []string{"a", "b"},
+			},
+			svalidator.ErrTooSmall,
+		},
+		{
+			"required error",
+			args{
+				validator: svalidator.Slice[string](nil).Required(),
+				input:     nil,
+			},
+			svalidator.ErrEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
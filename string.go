@@ -8,6 +8,7 @@ import (
 // UStringValidator is a validator for underlying string type.
 type UStringValidator[T ~string] struct {
 	*Validator[T]
+	transforms []func(T) T
 }
 
 func UString[T ~string]() *UStringValidator[T] {
@@ -84,6 +85,12 @@ func (s *UStringValidator[T]) AppendValidate(funcs ...Validate[T]) *UStringValid
 	return s
 }
 
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (s *UStringValidator[T]) AppendValidator(inner ValueValidator[T]) *UStringValidator[T] {
+	s.Validator = s.Validator.AppendValidator(inner)
+	return s
+}
+
 // PointerUStringValidator is a validator for pointer of underlying string type.
 type PointerUStringValidator[T ~string] struct {
 	*Validator[*T]
@@ -169,3 +176,9 @@ func (s *PointerUStringValidator[T]) AppendValidate(funcs ...Validate[*T]) *Poin
 	s.Validator = s.Validator.AppendValidate(funcs...)
 	return s
 }
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (s *PointerUStringValidator[T]) AppendValidator(inner ValueValidator[*T]) *PointerUStringValidator[T] {
+	s.Validator = s.Validator.AppendValidator(inner)
+	return s
+}
@@ -0,0 +1,184 @@
+package svalidator
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run gen_text_tables.go
+
+// graphemeProp is Grapheme_Cluster_Break property defined by UAX #29.
+type graphemeProp int
+
+const (
+	gpOther graphemeProp = iota
+	gpPrepend
+	gpCR
+	gpLF
+	gpControl
+	gpExtend
+	gpZWJ
+	gpRegionalIndicator
+	gpSpacingMark
+	gpL
+	gpV
+	gpT
+	gpLV
+	gpLVT
+	gpExtendedPictographic
+)
+
+// graphemeRange is a range of code points which have prop.
+type graphemeRange struct {
+	lo, hi rune
+	prop   graphemeProp
+}
+
+func graphemePropOf(r rune) graphemeProp {
+	switch {
+	case r == '\r':
+		return gpCR
+	case r == '\n':
+		return gpLF
+	case r < 0x20 || r == 0x7f:
+		return gpControl
+	case r < 0x7f:
+		return gpOther
+	}
+	i := sort.Search(len(graphemeRanges), func(i int) bool { return graphemeRanges[i].hi >= r })
+	if i < len(graphemeRanges) && graphemeRanges[i].lo <= r {
+		return graphemeRanges[i].prop
+	}
+	return gpOther
+}
+
+// graphemes calls yield with each extended grapheme cluster of s.
+func graphemes(s string, yield func(cluster string)) {
+	var (
+		start    int
+		prev     graphemeProp
+		pict     bool // the cluster matches ExtPict Extend*.
+		pictZWJ  bool // the cluster matches ExtPict Extend* ZWJ.
+		riCount  int
+		hasPrevR bool
+	)
+	for i, r := range s {
+		p := graphemePropOf(r)
+		if hasPrevR && graphemeBreak(prev, p, pictZWJ, riCount) {
+			yield(s[start:i])
+			start = i
+		}
+		hasPrevR = true
+
+		switch {
+		case p == gpExtendedPictographic:
+			pict, pictZWJ = true, false
+		case p == gpExtend && pict:
+		case p == gpZWJ && pict:
+			pict, pictZWJ = false, true
+		default:
+			pict, pictZWJ = false, false
+		}
+		if p == gpRegionalIndicator {
+			riCount++
+		} else {
+			riCount = 0
+		}
+		prev = p
+	}
+	if start < len(s) {
+		yield(s[start:])
+	}
+}
+
+func graphemeBreak(prev, cur graphemeProp, pictZWJ bool, riCount int) bool {
+	switch {
+	case prev == gpCR && cur == gpLF:
+		return false
+	case prev == gpCR || prev == gpLF || prev == gpControl:
+		return true
+	case cur == gpCR || cur == gpLF || cur == gpControl:
+		return true
+	case prev == gpL && (cur == gpL || cur == gpV || cur == gpLV || cur == gpLVT):
+		return false
+	case (prev == gpLV || prev == gpV) && (cur == gpV || cur == gpT):
+		return false
+	case (prev == gpLVT || prev == gpT) && cur == gpT:
+		return false
+	case cur == gpExtend || cur == gpZWJ || cur == gpSpacingMark:
+		return false
+	case prev == gpPrepend:
+		return false
+	case prev == gpZWJ && cur == gpExtendedPictographic && pictZWJ:
+		return false
+	case prev == gpRegionalIndicator && cur == gpRegionalIndicator && riCount%2 == 1:
+		return false
+	}
+	return true
+}
+
+// graphemeCount returns the number of extended grapheme clusters in s.
+func graphemeCount(s string) int {
+	var n int
+	graphemes(s, func(string) { n++ })
+	return n
+}
+
+// displayWidth returns the width of s, counting East Asian wide and full-width characters as 2.
+// Ambiguous characters count as 1.
+func displayWidth(s string) int {
+	var width int
+	graphemes(s, func(cluster string) {
+		// prepended characters such as U+0600 ARABIC NUMBER SIGN precede the base character.
+		r, size := utf8.DecodeRuneInString(cluster)
+		for graphemePropOf(r) == gpPrepend && size < len(cluster) {
+			width += runeWidth(r)
+			cluster = cluster[size:]
+			r, size = utf8.DecodeRuneInString(cluster)
+		}
+		w := runeWidth(r)
+		// emoji presentation selector makes the cluster wide.
+		if w == 1 && containsRune(cluster, 0xfe0f) {
+			w = 2
+		}
+		// spacing marks such as half width dakuten take their own columns.
+		for _, r := range cluster[size:] {
+			if isSpacingExtend(r) {
+				w += runeWidth(r)
+			}
+		}
+		width += w
+	})
+	return width
+}
+
+// isSpacingExtend reports whether r extends a cluster but is displayed in its own columns.
+// Emoji modifiers are drawn over the base emoji, and other parts of emoji and Hangul sequences
+// are not Extend nor SpacingMark.
+func isSpacingExtend(r rune) bool {
+	switch graphemePropOf(r) {
+	case gpExtend, gpSpacingMark:
+		return !(0x1f3fb <= r && r <= 0x1f3ff)
+	}
+	return false
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Mn, unicode.Me, unicode.Zl, unicode.Zp):
+		return 0
+	case unicode.Is(eastAsianWideTable, r):
+		return 2
+	}
+	return 1
+}
+
+func containsRune(s string, target rune) bool {
+	for _, r := range s {
+		if r == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1573 @@
+// Code generated by gen_text_tables.go from Unicode 14.0.0; DO NOT EDIT.
+
+package svalidator
+
+import "unicode"
+
+// graphemeRanges lists Grapheme_Cluster_Break and Extended_Pictographic properties in code point order.
+// Code points which are not listed are Other.
+var graphemeRanges = []graphemeRange{
+	{0x0000, 0x0009, gpControl},
+	{0x000a, 0x000a, gpLF},
+	{0x000b, 0x000c, gpControl},
+	{0x000d, 0x000d, gpCR},
+	{0x000e, 0x001f, gpControl},
+	{0x007f, 0x009f, gpControl},
+	{0x00a9, 0x00a9, gpExtendedPictographic},
+	{0x00ad, 0x00ad, gpControl},
+	{0x00ae, 0x00ae, gpExtendedPictographic},
+	{0x0300, 0x036f, gpExtend},
+	{0x0483, 0x0489, gpExtend},
+	{0x0591, 0x05bd, gpExtend},
+	{0x05bf, 0x05bf, gpExtend},
+	{0x05c1, 0x05c2, gpExtend},
+	{0x05c4, 0x05c5, gpExtend},
+	{0x05c7, 0x05c7, gpExtend},
+	{0x0600, 0x0605, gpPrepend},
+	{0x0610, 0x061a, gpExtend},
+	{0x061c, 0x061c, gpControl},
+	{0x064b, 0x065f, gpExtend},
+	{0x0670, 0x0670, gpExtend},
+	{0x06d6, 0x06dc, gpExtend},
+	{0x06dd, 0x06dd, gpPrepend},
+	{0x06df, 0x06e4, gpExtend},
+	{0x06e7, 0x06e8, gpExtend},
+	{0x06ea, 0x06ed, gpExtend},
+	{0x070f, 0x070f, gpPrepend},
+	{0x0711, 0x0711, gpExtend},
+	{0x0730, 0x074a, gpExtend},
+	{0x07a6, 0x07b0, gpExtend},
+	{0x07eb, 0x07f3, gpExtend},
+	{0x07fd, 0x07fd, gpExtend},
+	{0x0816, 0x0819, gpExtend},
+	{0x081b, 0x0823, gpExtend},
+	{0x0825, 0x0827, gpExtend},
+	{0x0829, 0x082d, gpExtend},
+	{0x0859, 0x085b, gpExtend},
+	{0x0890, 0x0891, gpPrepend},
+	{0x0898, 0x089f, gpExtend},
+	{0x08ca, 0x08e1, gpExtend},
+	{0x08e2, 0x08e2, gpPrepend},
+	{0x08e3, 0x0902, gpExtend},
+	{0x0903, 0x0903, gpSpacingMark},
+	{0x093a, 0x093a, gpExtend},
+	{0x093b, 0x093b, gpSpacingMark},
+	{0x093c, 0x093c, gpExtend},
+	{0x093e, 0x0940, gpSpacingMark},
+	{0x0941, 0x0948, gpExtend},
+	{0x0949, 0x094c, gpSpacingMark},
+	{0x094d, 0x094d, gpExtend},
+	{0x094e, 0x094f, gpSpacingMark},
+	{0x0951, 0x0957, gpExtend},
+	{0x0962, 0x0963, gpExtend},
+	{0x0981, 0x0981, gpExtend},
+	{0x0982, 0x0983, gpSpacingMark},
+	{0x09bc, 0x09bc, gpExtend},
+	{0x09be, 0x09be, gpExtend},
+	{0x09bf, 0x09c0, gpSpacingMark},
+	{0x09c1, 0x09c4, gpExtend},
+	{0x09c7, 0x09c8, gpSpacingMark},
+	{0x09cb, 0x09cc, gpSpacingMark},
+	{0x09cd, 0x09cd, gpExtend},
+	{0x09d7, 0x09d7, gpExtend},
+	{0x09e2, 0x09e3, gpExtend},
+	{0x09fe, 0x09fe, gpExtend},
+	{0x0a01, 0x0a02, gpExtend},
+	{0x0a03, 0x0a03, gpSpacingMark},
+	{0x0a3c, 0x0a3c, gpExtend},
+	{0x0a3e, 0x0a40, gpSpacingMark},
+	{0x0a41, 0x0a42, gpExtend},
+	{0x0a47, 0x0a48, gpExtend},
+	{0x0a4b, 0x0a4d, gpExtend},
+	{0x0a51, 0x0a51, gpExtend},
+	{0x0a70, 0x0a71, gpExtend},
+	{0x0a75, 0x0a75, gpExtend},
+	{0x0a81, 0x0a82, gpExtend},
+	{0x0a83, 0x0a83, gpSpacingMark},
+	{0x0abc, 0x0abc, gpExtend},
+	{0x0abe, 0x0ac0, gpSpacingMark},
+	{0x0ac1, 0x0ac5, gpExtend},
+	{0x0ac7, 0x0ac8, gpExtend},
+	{0x0ac9, 0x0ac9, gpSpacingMark},
+	{0x0acb, 0x0acc, gpSpacingMark},
+	{0x0acd, 0x0acd, gpExtend},
+	{0x0ae2, 0x0ae3, gpExtend},
+	{0x0afa, 0x0aff, gpExtend},
+	{0x0b01, 0x0b01, gpExtend},
+	{0x0b02, 0x0b03, gpSpacingMark},
+	{0x0b3c, 0x0b3c, gpExtend},
+	{0x0b3e, 0x0b3f, gpExtend},
+	{0x0b40, 0x0b40, gpSpacingMark},
+	{0x0b41, 0x0b44, gpExtend},
+	{0x0b47, 0x0b48, gpSpacingMark},
+	{0x0b4b, 0x0b4c, gpSpacingMark},
+	{0x0b4d, 0x0b4d, gpExtend},
+	{0x0b55, 0x0b57, gpExtend},
+	{0x0b62, 0x0b63, gpExtend},
+	{0x0b82, 0x0b82, gpExtend},
+	{0x0bbe, 0x0bbe, gpExtend},
+	{0x0bbf, 0x0bbf, gpSpacingMark},
+	{0x0bc0, 0x0bc0, gpExtend},
+	{0x0bc1, 0x0bc2, gpSpacingMark},
+	{0x0bc6, 0x0bc8, gpSpacingMark},
+	{0x0bca, 0x0bcc, gpSpacingMark},
+	{0x0bcd, 0x0bcd, gpExtend},
+	{0x0bd7, 0x0bd7, gpExtend},
+	{0x0c00, 0x0c00, gpExtend},
+	{0x0c01, 0x0c03, gpSpacingMark},
+	{0x0c04, 0x0c04, gpExtend},
+	{0x0c3c, 0x0c3c, gpExtend},
+	{0x0c3e, 0x0c40, gpExtend},
+	{0x0c41, 0x0c44, gpSpacingMark},
+	{0x0c46, 0x0c48, gpExtend},
+	{0x0c4a, 0x0c4d, gpExtend},
+	{0x0c55, 0x0c56, gpExtend},
+	{0x0c62, 0x0c63, gpExtend},
+	{0x0c81, 0x0c81, gpExtend},
+	{0x0c82, 0x0c83, gpSpacingMark},
+	{0x0cbc, 0x0cbc, gpExtend},
+	{0x0cbe, 0x0cbe, gpSpacingMark},
+	{0x0cbf, 0x0cbf, gpExtend},
+	{0x0cc0, 0x0cc1, gpSpacingMark},
+	{0x0cc2, 0x0cc2, gpExtend},
+	{0x0cc3, 0x0cc4, gpSpacingMark},
+	{0x0cc6, 0x0cc6, gpExtend},
+	{0x0cc7, 0x0cc8, gpSpacingMark},
+	{0x0cca, 0x0ccb, gpSpacingMark},
+	{0x0ccc, 0x0ccd, gpExtend},
+	{0x0cd5, 0x0cd6, gpExtend},
+	{0x0ce2, 0x0ce3, gpExtend},
+	{0x0d00, 0x0d01, gpExtend},
+	{0x0d02, 0x0d03, gpSpacingMark},
+	{0x0d3b, 0x0d3c, gpExtend},
+	{0x0d3e, 0x0d3e, gpExtend},
+	{0x0d3f, 0x0d40, gpSpacingMark},
+	{0x0d41, 0x0d44, gpExtend},
+	{0x0d46, 0x0d48, gpSpacingMark},
+	{0x0d4a, 0x0d4c, gpSpacingMark},
+	{0x0d4d, 0x0d4d, gpExtend},
+	{0x0d4e, 0x0d4e, gpPrepend},
+	{0x0d57, 0x0d57, gpExtend},
+	{0x0d62, 0x0d63, gpExtend},
+	{0x0d81, 0x0d81, gpExtend},
+	{0x0d82, 0x0d83, gpSpacingMark},
+	{0x0dca, 0x0dca, gpExtend},
+	{0x0dcf, 0x0dcf, gpExtend},
+	{0x0dd0, 0x0dd1, gpSpacingMark},
+	{0x0dd2, 0x0dd4, gpExtend},
+	{0x0dd6, 0x0dd6, gpExtend},
+	{0x0dd8, 0x0dde, gpSpacingMark},
+	{0x0ddf, 0x0ddf, gpExtend},
+	{0x0df2, 0x0df3, gpSpacingMark},
+	{0x0e31, 0x0e31, gpExtend},
+	{0x0e33, 0x0e33, gpSpacingMark},
+	{0x0e34, 0x0e3a, gpExtend},
+	{0x0e47, 0x0e4e, gpExtend},
+	{0x0eb1, 0x0eb1, gpExtend},
+	{0x0eb3, 0x0eb3, gpSpacingMark},
+	{0x0eb4, 0x0ebc, gpExtend},
+	{0x0ec8, 0x0ecd, gpExtend},
+	{0x0f18, 0x0f19, gpExtend},
+	{0x0f35, 0x0f35, gpExtend},
+	{0x0f37, 0x0f37, gpExtend},
+	{0x0f39, 0x0f39, gpExtend},
+	{0x0f3e, 0x0f3f, gpSpacingMark},
+	{0x0f71, 0x0f7e, gpExtend},
+	{0x0f7f, 0x0f7f, gpSpacingMark},
+	{0x0f80, 0x0f84, gpExtend},
+	{0x0f86, 0x0f87, gpExtend},
+	{0x0f8d, 0x0f97, gpExtend},
+	{0x0f99, 0x0fbc, gpExtend},
+	{0x0fc6, 0x0fc6, gpExtend},
+	{0x102d, 0x1030, gpExtend},
+	{0x1031, 0x1031, gpSpacingMark},
+	{0x1032, 0x1037, gpExtend},
+	{0x1039, 0x103a, gpExtend},
+	{0x103b, 0x103c, gpSpacingMark},
+	{0x103d, 0x103e, gpExtend},
+	{0x1056, 0x1057, gpSpacingMark},
+	{0x1058, 0x1059, gpExtend},
+	{0x105e, 0x1060, gpExtend},
+	{0x1071, 0x1074, gpExtend},
+	{0x1082, 0x1082, gpExtend},
+	{0x1084, 0x1084, gpSpacingMark},
+	{0x1085, 0x1086, gpExtend},
+	{0x108d, 0x108d, gpExtend},
+	{0x109d, 0x109d, gpExtend},
+	{0x1100, 0x115f, gpL},
+	{0x1160, 0x11a7, gpV},
+	{0x11a8, 0x11ff, gpT},
+	{0x135d, 0x135f, gpExtend},
+	{0x1712, 0x1714, gpExtend},
+	{0x1715, 0x1715, gpSpacingMark},
+	{0x1732, 0x1733, gpExtend},
+	{0x1734, 0x1734, gpSpacingMark},
+	{0x1752, 0x1753, gpExtend},
+	{0x1772, 0x1773, gpExtend},
+	{0x17b4, 0x17b5, gpExtend},
+	{0x17b6, 0x17b6, gpSpacingMark},
+	{0x17b7, 0x17bd, gpExtend},
+	{0x17be, 0x17c5, gpSpacingMark},
+	{0x17c6, 0x17c6, gpExtend},
+	{0x17c7, 0x17c8, gpSpacingMark},
+	{0x17c9, 0x17d3, gpExtend},
+	{0x17dd, 0x17dd, gpExtend},
+	{0x180b, 0x180d, gpExtend},
+	{0x180e, 0x180e, gpControl},
+	{0x180f, 0x180f, gpExtend},
+	{0x1885, 0x1886, gpExtend},
+	{0x18a9, 0x18a9, gpExtend},
+	{0x1920, 0x1922, gpExtend},
+	{0x1923, 0x1926, gpSpacingMark},
+	{0x1927, 0x1928, gpExtend},
+	{0x1929, 0x192b, gpSpacingMark},
+	{0x1930, 0x1931, gpSpacingMark},
+	{0x1932, 0x1932, gpExtend},
+	{0x1933, 0x1938, gpSpacingMark},
+	{0x1939, 0x193b, gpExtend},
+	{0x1a17, 0x1a18, gpExtend},
+	{0x1a19, 0x1a1a, gpSpacingMark},
+	{0x1a1b, 0x1a1b, gpExtend},
+	{0x1a55, 0x1a55, gpSpacingMark},
+	{0x1a56, 0x1a56, gpExtend},
+	{0x1a57, 0x1a57, gpSpacingMark},
+	{0x1a58, 0x1a5e, gpExtend},
+	{0x1a60, 0x1a60, gpExtend},
+	{0x1a62, 0x1a62, gpExtend},
+	{0x1a65, 0x1a6c, gpExtend},
+	{0x1a6d, 0x1a72, gpSpacingMark},
+	{0x1a73, 0x1a7c, gpExtend},
+	{0x1a7f, 0x1a7f, gpExtend},
+	{0x1ab0, 0x1ace, gpExtend},
+	{0x1b00, 0x1b03, gpExtend},
+	{0x1b04, 0x1b04, gpSpacingMark},
+	{0x1b34, 0x1b3a, gpExtend},
+	{0x1b3b, 0x1b3b, gpSpacingMark},
+	{0x1b3c, 0x1b3c, gpExtend},
+	{0x1b3d, 0x1b41, gpSpacingMark},
+	{0x1b42, 0x1b42, gpExtend},
+	{0x1b43, 0x1b44, gpSpacingMark},
+	{0x1b6b, 0x1b73, gpExtend},
+	{0x1b80, 0x1b81, gpExtend},
+	{0x1b82, 0x1b82, gpSpacingMark},
+	{0x1ba1, 0x1ba1, gpSpacingMark},
+	{0x1ba2, 0x1ba5, gpExtend},
+	{0x1ba6, 0x1ba7, gpSpacingMark},
+	{0x1ba8, 0x1ba9, gpExtend},
+	{0x1baa, 0x1baa, gpSpacingMark},
+	{0x1bab, 0x1bad, gpExtend},
+	{0x1be6, 0x1be6, gpExtend},
+	{0x1be7, 0x1be7, gpSpacingMark},
+	{0x1be8, 0x1be9, gpExtend},
+	{0x1bea, 0x1bec, gpSpacingMark},
+	{0x1bed, 0x1bed, gpExtend},
+	{0x1bee, 0x1bee, gpSpacingMark},
+	{0x1bef, 0x1bf1, gpExtend},
+	{0x1bf2, 0x1bf3, gpSpacingMark},
+	{0x1c24, 0x1c2b, gpSpacingMark},
+	{0x1c2c, 0x1c33, gpExtend},
+	{0x1c34, 0x1c35, gpSpacingMark},
+	{0x1c36, 0x1c37, gpExtend},
+	{0x1cd0, 0x1cd2, gpExtend},
+	{0x1cd4, 0x1ce0, gpExtend},
+	{0x1ce1, 0x1ce1, gpSpacingMark},
+	{0x1ce2, 0x1ce8, gpExtend},
+	{0x1ced, 0x1ced, gpExtend},
+	{0x1cf4, 0x1cf4, gpExtend},
+	{0x1cf7, 0x1cf7, gpSpacingMark},
+	{0x1cf8, 0x1cf9, gpExtend},
+	{0x1dc0, 0x1dff, gpExtend},
+	{0x200b, 0x200b, gpControl},
+	{0x200c, 0x200c, gpExtend},
+	{0x200d, 0x200d, gpZWJ},
+	{0x200e, 0x200f, gpControl},
+	{0x2028, 0x202e, gpControl},
+	{0x203c, 0x203c, gpExtendedPictographic},
+	{0x2049, 0x2049, gpExtendedPictographic},
+	{0x2060, 0x206f, gpControl},
+	{0x20d0, 0x20f0, gpExtend},
+	{0x2122, 0x2122, gpExtendedPictographic},
+	{0x2139, 0x2139, gpExtendedPictographic},
+	{0x2194, 0x2199, gpExtendedPictographic},
+	{0x21a9, 0x21aa, gpExtendedPictographic},
+	{0x231a, 0x231b, gpExtendedPictographic},
+	{0x2328, 0x2328, gpExtendedPictographic},
+	{0x2388, 0x2388, gpExtendedPictographic},
+	{0x23cf, 0x23cf, gpExtendedPictographic},
+	{0x23e9, 0x23f3, gpExtendedPictographic},
+	{0x23f8, 0x23fa, gpExtendedPictographic},
+	{0x24c2, 0x24c2, gpExtendedPictographic},
+	{0x25aa, 0x25ab, gpExtendedPictographic},
+	{0x25b6, 0x25b6, gpExtendedPictographic},
+	{0x25c0, 0x25c0, gpExtendedPictographic},
+	{0x25fb, 0x25fe, gpExtendedPictographic},
+	{0x2600, 0x2605, gpExtendedPictographic},
+	{0x2607, 0x2612, gpExtendedPictographic},
+	{0x2614, 0x2685, gpExtendedPictographic},
+	{0x2690, 0x2705, gpExtendedPictographic},
+	{0x2708, 0x2712, gpExtendedPictographic},
+	{0x2714, 0x2714, gpExtendedPictographic},
+	{0x2716, 0x2716, gpExtendedPictographic},
+	{0x271d, 0x271d, gpExtendedPictographic},
+	{0x2721, 0x2721, gpExtendedPictographic},
+	{0x2728, 0x2728, gpExtendedPictographic},
+	{0x2733, 0x2734, gpExtendedPictographic},
+	{0x2744, 0x2744, gpExtendedPictographic},
+	{0x2747, 0x2747, gpExtendedPictographic},
+	{0x274c, 0x274c, gpExtendedPictographic},
+	{0x274e, 0x274e, gpExtendedPictographic},
+	{0x2753, 0x2755, gpExtendedPictographic},
+	{0x2757, 0x2757, gpExtendedPictographic},
+	{0x2763, 0x2767, gpExtendedPictographic},
+	{0x2795, 0x2797, gpExtendedPictographic},
+	{0x27a1, 0x27a1, gpExtendedPictographic},
+	{0x27b0, 0x27b0, gpExtendedPictographic},
+	{0x27bf, 0x27bf, gpExtendedPictographic},
+	{0x2934, 0x2935, gpExtendedPictographic},
+	{0x2b05, 0x2b07, gpExtendedPictographic},
+	{0x2b1b, 0x2b1c, gpExtendedPictographic},
+	{0x2b50, 0x2b50, gpExtendedPictographic},
+	{0x2b55, 0x2b55, gpExtendedPictographic},
+	{0x2cef, 0x2cf1, gpExtend},
+	{0x2d7f, 0x2d7f, gpExtend},
+	{0x2de0, 0x2dff, gpExtend},
+	{0x302a, 0x302f, gpExtend},
+	{0x3030, 0x3030, gpExtendedPictographic},
+	{0x303d, 0x303d, gpExtendedPictographic},
+	{0x3099, 0x309a, gpExtend},
+	{0x3297, 0x3297, gpExtendedPictographic},
+	{0x3299, 0x3299, gpExtendedPictographic},
+	{0xa66f, 0xa672, gpExtend},
+	{0xa674, 0xa67d, gpExtend},
+	{0xa69e, 0xa69f, gpExtend},
+	{0xa6f0, 0xa6f1, gpExtend},
+	{0xa802, 0xa802, gpExtend},
+	{0xa806, 0xa806, gpExtend},
+	{0xa80b, 0xa80b, gpExtend},
+	{0xa823, 0xa824, gpSpacingMark},
+	{0xa825, 0xa826, gpExtend},
+	{0xa827, 0xa827, gpSpacingMark},
+	{0xa82c, 0xa82c, gpExtend},
+	{0xa880, 0xa881, gpSpacingMark},
+	{0xa8b4, 0xa8c3, gpSpacingMark},
+	{0xa8c4, 0xa8c5, gpExtend},
+	{0xa8e0, 0xa8f1, gpExtend},
+	{0xa8ff, 0xa8ff, gpExtend},
+	{0xa926, 0xa92d, gpExtend},
+	{0xa947, 0xa951, gpExtend},
+	{0xa952, 0xa953, gpSpacingMark},
+	{0xa960, 0xa97c, gpL},
+	{0xa980, 0xa982, gpExtend},
+	{0xa983, 0xa983, gpSpacingMark},
+	{0xa9b3, 0xa9b3, gpExtend},
+	{0xa9b4, 0xa9b5, gpSpacingMark},
+	{0xa9b6, 0xa9b9, gpExtend},
+	{0xa9ba, 0xa9bb, gpSpacingMark},
+	{0xa9bc, 0xa9bd, gpExtend},
+	{0xa9be, 0xa9c0, gpSpacingMark},
+	{0xa9e5, 0xa9e5, gpExtend},
+	{0xaa29, 0xaa2e, gpExtend},
+	{0xaa2f, 0xaa30, gpSpacingMark},
+	{0xaa31, 0xaa32, gpExtend},
+	{0xaa33, 0xaa34, gpSpacingMark},
+	{0xaa35, 0xaa36, gpExtend},
+	{0xaa43, 0xaa43, gpExtend},
+	{0xaa4c, 0xaa4c, gpExtend},
+	{0xaa4d, 0xaa4d, gpSpacingMark},
+	{0xaa7c, 0xaa7c, gpExtend},
+	{0xaab0, 0xaab0, gpExtend},
+	{0xaab2, 0xaab4, gpExtend},
+	{0xaab7, 0xaab8, gpExtend},
+	{0xaabe, 0xaabf, gpExtend},
+	{0xaac1, 0xaac1, gpExtend},
+	{0xaaeb, 0xaaeb, gpSpacingMark},
+	{0xaaec, 0xaaed, gpExtend},
+	{0xaaee, 0xaaef, gpSpacingMark},
+	{0xaaf5, 0xaaf5, gpSpacingMark},
+	{0xaaf6, 0xaaf6, gpExtend},
+	{0xabe3, 0xabe4, gpSpacingMark},
+	{0xabe5, 0xabe5, gpExtend},
+	{0xabe6, 0xabe7, gpSpacingMark},
+	{0xabe8, 0xabe8, gpExtend},
+	{0xabe9, 0xabea, gpSpacingMark},
+	{0xabec, 0xabec, gpSpacingMark},
+	{0xabed, 0xabed, gpExtend},
+	{0xac00, 0xac00, gpLV},
+	{0xac01, 0xac1b, gpLVT},
+	{0xac1c, 0xac1c, gpLV},
+	{0xac1d, 0xac37, gpLVT},
+	{0xac38, 0xac38, gpLV},
+	{0xac39, 0xac53, gpLVT},
+	{0xac54, 0xac54, gpLV},
+	{0xac55, 0xac6f, gpLVT},
+	{0xac70, 0xac70, gpLV},
+	{0xac71, 0xac8b, gpLVT},
+	{0xac8c, 0xac8c, gpLV},
+	{0xac8d, 0xaca7, gpLVT},
+	{0xaca8, 0xaca8, gpLV},
+	{0xaca9, 0xacc3, gpLVT},
+	{0xacc4, 0xacc4, gpLV},
+	{0xacc5, 0xacdf, gpLVT},
+	{0xace0, 0xace0, gpLV},
+	{0xace1, 0xacfb, gpLVT},
+	{0xacfc, 0xacfc, gpLV},
+	{0xacfd, 0xad17, gpLVT},
+	{0xad18, 0xad18, gpLV},
+	{0xad19, 0xad33, gpLVT},
+	{0xad34, 0xad34, gpLV},
+	{0xad35, 0xad4f, gpLVT},
+	{0xad50, 0xad50, gpLV},
+	{0xad51, 0xad6b, gpLVT},
+	{0xad6c, 0xad6c, gpLV},
+	{0xad6d, 0xad87, gpLVT},
+	{0xad88, 0xad88, gpLV},
+	{0xad89, 0xada3, gpLVT},
+	{0xada4, 0xada4, gpLV},
+	{0xada5, 0xadbf, gpLVT},
+	{0xadc0, 0xadc0, gpLV},
+	{0xadc1, 0xaddb, gpLVT},
+	{0xaddc, 0xaddc, gpLV},
+	{0xaddd, 0xadf7, gpLVT},
+	{0xadf8, 0xadf8, gpLV},
+	{0xadf9, 0xae13, gpLVT},
+	{0xae14, 0xae14, gpLV},
+	{0xae15, 0xae2f, gpLVT},
+	{0xae30, 0xae30, gpLV},
+	{0xae31, 0xae4b, gpLVT},
+	{0xae4c, 0xae4c, gpLV},
+	{0xae4d, 0xae67, gpLVT},
+	{0xae68, 0xae68, gpLV},
+	{0xae69, 0xae83, gpLVT},
+	{0xae84, 0xae84, gpLV},
+	{0xae85, 0xae9f, gpLVT},
+	{0xaea0, 0xaea0, gpLV},
+	{0xaea1, 0xaebb, gpLVT},
+	{0xaebc, 0xaebc, gpLV},
+	{0xaebd, 0xaed7, gpLVT},
+	{0xaed8, 0xaed8, gpLV},
+	{0xaed9, 0xaef3, gpLVT},
+	{0xaef4, 0xaef4, gpLV},
+	{0xaef5, 0xaf0f, gpLVT},
+	{0xaf10, 0xaf10, gpLV},
+	{0xaf11, 0xaf2b, gpLVT},
+	{0xaf2c, 0xaf2c, gpLV},
+	{0xaf2d, 0xaf47, gpLVT},
+	{0xaf48, 0xaf48, gpLV},
+	{0xaf49, 0xaf63, gpLVT},
+	{0xaf64, 0xaf64, gpLV},
+	{0xaf65, 0xaf7f, gpLVT},
+	{0xaf80, 0xaf80, gpLV},
+	{0xaf81, 0xaf9b, gpLVT},
+	{0xaf9c, 0xaf9c, gpLV},
+	{0xaf9d, 0xafb7, gpLVT},
+	{0xafb8, 0xafb8, gpLV},
+	{0xafb9, 0xafd3, gpLVT},
+	{0xafd4, 0xafd4, gpLV},
+	{0xafd5, 0xafef, gpLVT},
+	{0xaff0, 0xaff0, gpLV},
+	{0xaff1, 0xb00b, gpLVT},
+	{0xb00c, 0xb00c, gpLV},
+	{0xb00d, 0xb027, gpLVT},
+	{0xb028, 0xb028, gpLV},
+	{0xb029, 0xb043, gpLVT},
+	{0xb044, 0xb044, gpLV},
+	{0xb045, 0xb05f, gpLVT},
+	{0xb060, 0xb060, gpLV},
+	{0xb061, 0xb07b, gpLVT},
+	{0xb07c, 0xb07c, gpLV},
+	{0xb07d, 0xb097, gpLVT},
+	{0xb098, 0xb098, gpLV},
+	{0xb099, 0xb0b3, gpLVT},
+	{0xb0b4, 0xb0b4, gpLV},
+	{0xb0b5, 0xb0cf, gpLVT},
+	{0xb0d0, 0xb0d0, gpLV},
+	{0xb0d1, 0xb0eb, gpLVT},
+	{0xb0ec, 0xb0ec, gpLV},
+	{0xb0ed, 0xb107, gpLVT},
+	{0xb108, 0xb108, gpLV},
+	{0xb109, 0xb123, gpLVT},
+	{0xb124, 0xb124, gpLV},
+	{0xb125, 0xb13f, gpLVT},
+	{0xb140, 0xb140, gpLV},
+	{0xb141, 0xb15b, gpLVT},
+	{0xb15c, 0xb15c, gpLV},
+	{0xb15d, 0xb177, gpLVT},
+	{0xb178, 0xb178, gpLV},
+	{0xb179, 0xb193, gpLVT},
+	{0xb194, 0xb194, gpLV},
+	{0xb195, 0xb1af, gpLVT},
+	{0xb1b0, 0xb1b0, gpLV},
+	{0xb1b1, 0xb1cb, gpLVT},
+	{0xb1cc, 0xb1cc, gpLV},
+	{0xb1cd, 0xb1e7, gpLVT},
+	{0xb1e8, 0xb1e8, gpLV},
+	{0xb1e9, 0xb203, gpLVT},
+	{0xb204, 0xb204, gpLV},
+	{0xb205, 0xb21f, gpLVT},
+	{0xb220, 0xb220, gpLV},
+	{0xb221, 0xb23b, gpLVT},
+	{0xb23c, 0xb23c, gpLV},
+	{0xb23d, 0xb257, gpLVT},
+	{0xb258, 0xb258, gpLV},
+	{0xb259, 0xb273, gpLVT},
+	{0xb274, 0xb274, gpLV},
+	{0xb275, 0xb28f, gpLVT},
+	{0xb290, 0xb290, gpLV},
+	{0xb291, 0xb2ab, gpLVT},
+	{0xb2ac, 0xb2ac, gpLV},
+	{0xb2ad, 0xb2c7, gpLVT},
+	{0xb2c8, 0xb2c8, gpLV},
+	{0xb2c9, 0xb2e3, gpLVT},
+	{0xb2e4, 0xb2e4, gpLV},
+	{0xb2e5, 0xb2ff, gpLVT},
+	{0xb300, 0xb300, gpLV},
+	{0xb301, 0xb31b, gpLVT},
+	{0xb31c, 0xb31c, gpLV},
+	{0xb31d, 0xb337, gpLVT},
+	{0xb338, 0xb338, gpLV},
+	{0xb339, 0xb353, gpLVT},
+	{0xb354, 0xb354, gpLV},
+	{0xb355, 0xb36f, gpLVT},
+	{0xb370, 0xb370, gpLV},
+	{0xb371, 0xb38b, gpLVT},
+	{0xb38c, 0xb38c, gpLV},
+	{0xb38d, 0xb3a7, gpLVT},
+	{0xb3a8, 0xb3a8, gpLV},
+	{0xb3a9, 0xb3c3, gpLVT},
+	{0xb3c4, 0xb3c4, gpLV},
+	{0xb3c5, 0xb3df, gpLVT},
+	{0xb3e0, 0xb3e0, gpLV},
+	{0xb3e1, 0xb3fb, gpLVT},
+	{0xb3fc, 0xb3fc, gpLV},
+	{0xb3fd, 0xb417, gpLVT},
+	{0xb418, 0xb418, gpLV},
+	{0xb419, 0xb433, gpLVT},
+	{0xb434, 0xb434, gpLV},
+	{0xb435, 0xb44f, gpLVT},
+	{0xb450, 0xb450, gpLV},
+	{0xb451, 0xb46b, gpLVT},
+	{0xb46c, 0xb46c, gpLV},
+	{0xb46d, 0xb487, gpLVT},
+	{0xb488, 0xb488, gpLV},
+	{0xb489, 0xb4a3, gpLVT},
+	{0xb4a4, 0xb4a4, gpLV},
+	{0xb4a5, 0xb4bf, gpLVT},
+	{0xb4c0, 0xb4c0, gpLV},
+	{0xb4c1, 0xb4db, gpLVT},
+	{0xb4dc, 0xb4dc, gpLV},
+	{0xb4dd, 0xb4f7, gpLVT},
+	{0xb4f8, 0xb4f8, gpLV},
+	{0xb4f9, 0xb513, gpLVT},
+	{0xb514, 0xb514, gpLV},
+	{0xb515, 0xb52f, gpLVT},
+	{0xb530, 0xb530, gpLV},
+	{0xb531, 0xb54b, gpLVT},
+	{0xb54c, 0xb54c, gpLV},
+	{0xb54d, 0xb567, gpLVT},
+	{0xb568, 0xb568, gpLV},
+	{0xb569, 0xb583, gpLVT},
+	{0xb584, 0xb584, gpLV},
+	{0xb585, 0xb59f, gpLVT},
+	{0xb5a0, 0xb5a0, gpLV},
+	{0xb5a1, 0xb5bb, gpLVT},
+	{0xb5bc, 0xb5bc, gpLV},
+	{0xb5bd, 0xb5d7, gpLVT},
+	{0xb5d8, 0xb5d8, gpLV},
+	{0xb5d9, 0xb5f3, gpLVT},
+	{0xb5f4, 0xb5f4, gpLV},
+	{0xb5f5, 0xb60f, gpLVT},
+	{0xb610, 0xb610, gpLV},
+	{0xb611, 0xb62b, gpLVT},
+	{0xb62c, 0xb62c, gpLV},
+	{0xb62d, 0xb647, gpLVT},
+	{0xb648, 0xb648, gpLV},
+	{0xb649, 0xb663, gpLVT},
+	{0xb664, 0xb664, gpLV},
+	{0xb665, 0xb67f, gpLVT},
+	{0xb680, 0xb680, gpLV},
+	{0xb681, 0xb69b, gpLVT},
+	{0xb69c, 0xb69c, gpLV},
+	{0xb69d, 0xb6b7, gpLVT},
+	{0xb6b8, 0xb6b8, gpLV},
+	{0xb6b9, 0xb6d3, gpLVT},
+	{0xb6d4, 0xb6d4, gpLV},
+	{0xb6d5, 0xb6ef, gpLVT},
+	{0xb6f0, 0xb6f0, gpLV},
+	{0xb6f1, 0xb70b, gpLVT},
+	{0xb70c, 0xb70c, gpLV},
+	{0xb70d, 0xb727, gpLVT},
+	{0xb728, 0xb728, gpLV},
+	{0xb729, 0xb743, gpLVT},
+	{0xb744, 0xb744, gpLV},
+	{0xb745, 0xb75f, gpLVT},
+	{0xb760, 0xb760, gpLV},
+	{0xb761, 0xb77b, gpLVT},
+	{0xb77c, 0xb77c, gpLV},
+	{0xb77d, 0xb797, gpLVT},
+	{0xb798, 0xb798, gpLV},
+	{0xb799, 0xb7b3, gpLVT},
+	{0xb7b4, 0xb7b4, gpLV},
+	{0xb7b5, 0xb7cf, gpLVT},
+	{0xb7d0, 0xb7d0, gpLV},
+	{0xb7d1, 0xb7eb, gpLVT},
+	{0xb7ec, 0xb7ec, gpLV},
+	{0xb7ed, 0xb807, gpLVT},
+	{0xb808, 0xb808, gpLV},
+	{0xb809, 0xb823, gpLVT},
+	{0xb824, 0xb824, gpLV},
+	{0xb825, 0xb83f, gpLVT},
+	{0xb840, 0xb840, gpLV},
+	{0xb841, 0xb85b, gpLVT},
+	{0xb85c, 0xb85c, gpLV},
+	{0xb85d, 0xb877, gpLVT},
+	{0xb878, 0xb878, gpLV},
+	{0xb879, 0xb893, gpLVT},
+	{0xb894, 0xb894, gpLV},
+	{0xb895, 0xb8af, gpLVT},
+	{0xb8b0, 0xb8b0, gpLV},
+	{0xb8b1, 0xb8cb, gpLVT},
+	{0xb8cc, 0xb8cc, gpLV},
+	{0xb8cd, 0xb8e7, gpLVT},
+	{0xb8e8, 0xb8e8, gpLV},
+	{0xb8e9, 0xb903, gpLVT},
+	{0xb904, 0xb904, gpLV},
+	{0xb905, 0xb91f, gpLVT},
+	{0xb920, 0xb920, gpLV},
+	{0xb921, 0xb93b, gpLVT},
+	{0xb93c, 0xb93c, gpLV},
+	{0xb93d, 0xb957, gpLVT},
+	{0xb958, 0xb958, gpLV},
+	{0xb959, 0xb973, gpLVT},
+	{0xb974, 0xb974, gpLV},
+	{0xb975, 0xb98f, gpLVT},
+	{0xb990, 0xb990, gpLV},
+	{0xb991, 0xb9ab, gpLVT},
+	{0xb9ac, 0xb9ac, gpLV},
+	{0xb9ad, 0xb9c7, gpLVT},
+	{0xb9c8, 0xb9c8, gpLV},
+	{0xb9c9, 0xb9e3, gpLVT},
+	{0xb9e4, 0xb9e4, gpLV},
+	{0xb9e5, 0xb9ff, gpLVT},
+	{0xba00, 0xba00, gpLV},
+	{0xba01, 0xba1b, gpLVT},
+	{0xba1c, 0xba1c, gpLV},
+	{0xba1d, 0xba37, gpLVT},
+	{0xba38, 0xba38, gpLV},
+	{0xba39, 0xba53, gpLVT},
+	{0xba54, 0xba54, gpLV},
+	{0xba55, 0xba6f, gpLVT},
+	{0xba70, 0xba70, gpLV},
+	{0xba71, 0xba8b, gpLVT},
+	{0xba8c, 0xba8c, gpLV},
+	{0xba8d, 0xbaa7, gpLVT},
+	{0xbaa8, 0xbaa8, gpLV},
+	{0xbaa9, 0xbac3, gpLVT},
+	{0xbac4, 0xbac4, gpLV},
+	{0xbac5, 0xbadf, gpLVT},
+	{0xbae0, 0xbae0, gpLV},
+	{0xbae1, 0xbafb, gpLVT},
+	{0xbafc, 0xbafc, gpLV},
+	{0xbafd, 0xbb17, gpLVT},
+	{0xbb18, 0xbb18, gpLV},
+	{0xbb19, 0xbb33, gpLVT},
+	{0xbb34, 0xbb34, gpLV},
+	{0xbb35, 0xbb4f, gpLVT},
+	{0xbb50, 0xbb50, gpLV},
+	{0xbb51, 0xbb6b, gpLVT},
+	{0xbb6c, 0xbb6c, gpLV},
+	{0xbb6d, 0xbb87, gpLVT},
+	{0xbb88, 0xbb88, gpLV},
+	{0xbb89, 0xbba3, gpLVT},
+	{0xbba4, 0xbba4, gpLV},
+	{0xbba5, 0xbbbf, gpLVT},
+	{0xbbc0, 0xbbc0, gpLV},
+	{0xbbc1, 0xbbdb, gpLVT},
+	{0xbbdc, 0xbbdc, gpLV},
+	{0xbbdd, 0xbbf7, gpLVT},
+	{0xbbf8, 0xbbf8, gpLV},
+	{0xbbf9, 0xbc13, gpLVT},
+	{0xbc14, 0xbc14, gpLV},
+	{0xbc15, 0xbc2f, gpLVT},
+	{0xbc30, 0xbc30, gpLV},
+	{0xbc31, 0xbc4b, gpLVT},
+	{0xbc4c, 0xbc4c, gpLV},
+	{0xbc4d, 0xbc67, gpLVT},
+	{0xbc68, 0xbc68, gpLV},
+	{0xbc69, 0xbc83, gpLVT},
+	{0xbc84, 0xbc84, gpLV},
+	{0xbc85, 0xbc9f, gpLVT},
+	{0xbca0, 0xbca0, gpLV},
+	{0xbca1, 0xbcbb, gpLVT},
+	{0xbcbc, 0xbcbc, gpLV},
+	{0xbcbd, 0xbcd7, gpLVT},
+	{0xbcd8, 0xbcd8, gpLV},
+	{0xbcd9, 0xbcf3, gpLVT},
+	{0xbcf4, 0xbcf4, gpLV},
+	{0xbcf5, 0xbd0f, gpLVT},
+	{0xbd10, 0xbd10, gpLV},
+	{0xbd11, 0xbd2b, gpLVT},
+	{0xbd2c, 0xbd2c, gpLV},
+	{0xbd2d, 0xbd47, gpLVT},
+	{0xbd48, 0xbd48, gpLV},
+	{0xbd49, 0xbd63, gpLVT},
+	{0xbd64, 0xbd64, gpLV},
+	{0xbd65, 0xbd7f, gpLVT},
+	{0xbd80, 0xbd80, gpLV},
+	{0xbd81, 0xbd9b, gpLVT},
+	{0xbd9c, 0xbd9c, gpLV},
+	{0xbd9d, 0xbdb7, gpLVT},
+	{0xbdb8, 0xbdb8, gpLV},
+	{0xbdb9, 0xbdd3, gpLVT},
+	{0xbdd4, 0xbdd4, gpLV},
+	{0xbdd5, 0xbdef, gpLVT},
+	{0xbdf0, 0xbdf0, gpLV},
+	{0xbdf1, 0xbe0b, gpLVT},
+	{0xbe0c, 0xbe0c, gpLV},
+	{0xbe0d, 0xbe27, gpLVT},
+	{0xbe28, 0xbe28, gpLV},
+	{0xbe29, 0xbe43, gpLVT},
+	{0xbe44, 0xbe44, gpLV},
+	{0xbe45, 0xbe5f, gpLVT},
+	{0xbe60, 0xbe60, gpLV},
+	{0xbe61, 0xbe7b, gpLVT},
+	{0xbe7c, 0xbe7c, gpLV},
+	{0xbe7d, 0xbe97, gpLVT},
+	{0xbe98, 0xbe98, gpLV},
+	{0xbe99, 0xbeb3, gpLVT},
+	{0xbeb4, 0xbeb4, gpLV},
+	{0xbeb5, 0xbecf, gpLVT},
+	{0xbed0, 0xbed0, gpLV},
+	{0xbed1, 0xbeeb, gpLVT},
+	{0xbeec, 0xbeec, gpLV},
+	{0xbeed, 0xbf07, gpLVT},
+	{0xbf08, 0xbf08, gpLV},
+	{0xbf09, 0xbf23, gpLVT},
+	{0xbf24, 0xbf24, gpLV},
+	{0xbf25, 0xbf3f, gpLVT},
+	{0xbf40, 0xbf40, gpLV},
+	{0xbf41, 0xbf5b, gpLVT},
+	{0xbf5c, 0xbf5c, gpLV},
+	{0xbf5d, 0xbf77, gpLVT},
+	{0xbf78, 0xbf78, gpLV},
+	{0xbf79, 0xbf93, gpLVT},
+	{0xbf94, 0xbf94, gpLV},
+	{0xbf95, 0xbfaf, gpLVT},
+	{0xbfb0, 0xbfb0, gpLV},
+	{0xbfb1, 0xbfcb, gpLVT},
+	{0xbfcc, 0xbfcc, gpLV},
+	{0xbfcd, 0xbfe7, gpLVT},
+	{0xbfe8, 0xbfe8, gpLV},
+	{0xbfe9, 0xc003, gpLVT},
+	{0xc004, 0xc004, gpLV},
+	{0xc005, 0xc01f, gpLVT},
+	{0xc020, 0xc020, gpLV},
+	{0xc021, 0xc03b, gpLVT},
+	{0xc03c, 0xc03c, gpLV},
+	{0xc03d, 0xc057, gpLVT},
+	{0xc058, 0xc058, gpLV},
+	{0xc059, 0xc073, gpLVT},
+	{0xc074, 0xc074, gpLV},
+	{0xc075, 0xc08f, gpLVT},
+	{0xc090, 0xc090, gpLV},
+	{0xc091, 0xc0ab, gpLVT},
+	{0xc0ac, 0xc0ac, gpLV},
+	{0xc0ad, 0xc0c7, gpLVT},
+	{0xc0c8, 0xc0c8, gpLV},
+	{0xc0c9, 0xc0e3, gpLVT},
+	{0xc0e4, 0xc0e4, gpLV},
+	{0xc0e5, 0xc0ff, gpLVT},
+	{0xc100, 0xc100, gpLV},
+	{0xc101, 0xc11b, gpLVT},
+	{0xc11c, 0xc11c, gpLV},
+	{0xc11d, 0xc137, gpLVT},
+	{0xc138, 0xc138, gpLV},
+	{0xc139, 0xc153, gpLVT},
+	{0xc154, 0xc154, gpLV},
+	{0xc155, 0xc16f, gpLVT},
+	{0xc170, 0xc170, gpLV},
+	{0xc171, 0xc18b, gpLVT},
+	{0xc18c, 0xc18c, gpLV},
+	{0xc18d, 0xc1a7, gpLVT},
+	{0xc1a8, 0xc1a8, gpLV},
+	{0xc1a9, 0xc1c3, gpLVT},
+	{0xc1c4, 0xc1c4, gpLV},
+	{0xc1c5, 0xc1df, gpLVT},
+	{0xc1e0, 0xc1e0, gpLV},
+	{0xc1e1, 0xc1fb, gpLVT},
+	{0xc1fc, 0xc1fc, gpLV},
+	{0xc1fd, 0xc217, gpLVT},
+	{0xc218, 0xc218, gpLV},
+	{0xc219, 0xc233, gpLVT},
+	{0xc234, 0xc234, gpLV},
+	{0xc235, 0xc24f, gpLVT},
+	{0xc250, 0xc250, gpLV},
+	{0xc251, 0xc26b, gpLVT},
+	{0xc26c, 0xc26c, gpLV},
+	{0xc26d, 0xc287, gpLVT},
+	{0xc288, 0xc288, gpLV},
+	{0xc289, 0xc2a3, gpLVT},
+	{0xc2a4, 0xc2a4, gpLV},
+	{0xc2a5, 0xc2bf, gpLVT},
+	{0xc2c0, 0xc2c0, gpLV},
+	{0xc2c1, 0xc2db, gpLVT},
+	{0xc2dc, 0xc2dc, gpLV},
+	{0xc2dd, 0xc2f7, gpLVT},
+	{0xc2f8, 0xc2f8, gpLV},
+	{0xc2f9, 0xc313, gpLVT},
+	{0xc314, 0xc314, gpLV},
+	{0xc315, 0xc32f, gpLVT},
+	{0xc330, 0xc330, gpLV},
+	{0xc331, 0xc34b, gpLVT},
+	{0xc34c, 0xc34c, gpLV},
+	{0xc34d, 0xc367, gpLVT},
+	{0xc368, 0xc368, gpLV},
+	{0xc369, 0xc383, gpLVT},
+	{0xc384, 0xc384, gpLV},
+	{0xc385, 0xc39f, gpLVT},
+	{0xc3a0, 0xc3a0, gpLV},
+	{0xc3a1, 0xc3bb, gpLVT},
+	{0xc3bc, 0xc3bc, gpLV},
+	{0xc3bd, 0xc3d7, gpLVT},
+	{0xc3d8, 0xc3d8, gpLV},
+	{0xc3d9, 0xc3f3, gpLVT},
+	{0xc3f4, 0xc3f4, gpLV},
+	{0xc3f5, 0xc40f, gpLVT},
+	{0xc410, 0xc410, gpLV},
+	{0xc411, 0xc42b, gpLVT},
+	{0xc42c, 0xc42c, gpLV},
+	{0xc42d, 0xc447, gpLVT},
+	{0xc448, 0xc448, gpLV},
+	{0xc449, 0xc463, gpLVT},
+	{0xc464, 0xc464, gpLV},
+	{0xc465, 0xc47f, gpLVT},
+	{0xc480, 0xc480, gpLV},
+	{0xc481, 0xc49b, gpLVT},
+	{0xc49c, 0xc49c, gpLV},
+	{0xc49d, 0xc4b7, gpLVT},
+	{0xc4b8, 0xc4b8, gpLV},
+	{0xc4b9, 0xc4d3, gpLVT},
+	{0xc4d4, 0xc4d4, gpLV},
+	{0xc4d5, 0xc4ef, gpLVT},
+	{0xc4f0, 0xc4f0, gpLV},
+	{0xc4f1, 0xc50b, gpLVT},
+	{0xc50c, 0xc50c, gpLV},
+	{0xc50d, 0xc527, gpLVT},
+	{0xc528, 0xc528, gpLV},
+	{0xc529, 0xc543, gpLVT},
+	{0xc544, 0xc544, gpLV},
+	{0xc545, 0xc55f, gpLVT},
+	{0xc560, 0xc560, gpLV},
+	{0xc561, 0xc57b, gpLVT},
+	{0xc57c, 0xc57c, gpLV},
+	{0xc57d, 0xc597, gpLVT},
+	{0xc598, 0xc598, gpLV},
+	{0xc599, 0xc5b3, gpLVT},
+	{0xc5b4, 0xc5b4, gpLV},
+	{0xc5b5, 0xc5cf, gpLVT},
+	{0xc5d0, 0xc5d0, gpLV},
+	{0xc5d1, 0xc5eb, gpLVT},
+	{0xc5ec, 0xc5ec, gpLV},
+	{0xc5ed, 0xc607, gpLVT},
+	{0xc608, 0xc608, gpLV},
+	{0xc609, 0xc623, gpLVT},
+	{0xc624, 0xc624, gpLV},
+	{0xc625, 0xc63f, gpLVT},
+	{0xc640, 0xc640, gpLV},
+	{0xc641, 0xc65b, gpLVT},
+	{0xc65c, 0xc65c, gpLV},
+	{0xc65d, 0xc677, gpLVT},
+	{0xc678, 0xc678, gpLV},
+	{0xc679, 0xc693, gpLVT},
+	{0xc694, 0xc694, gpLV},
+	{0xc695, 0xc6af, gpLVT},
+	{0xc6b0, 0xc6b0, gpLV},
+	{0xc6b1, 0xc6cb, gpLVT},
+	{0xc6cc, 0xc6cc, gpLV},
+	{0xc6cd, 0xc6e7, gpLVT},
+	{0xc6e8, 0xc6e8, gpLV},
+	{0xc6e9, 0xc703, gpLVT},
+	{0xc704, 0xc704, gpLV},
+	{0xc705, 0xc71f, gpLVT},
+	{0xc720, 0xc720, gpLV},
+	{0xc721, 0xc73b, gpLVT},
+	{0xc73c, 0xc73c, gpLV},
+	{0xc73d, 0xc757, gpLVT},
+	{0xc758, 0xc758, gpLV},
+	{0xc759, 0xc773, gpLVT},
+	{0xc774, 0xc774, gpLV},
+	{0xc775, 0xc78f, gpLVT},
+	{0xc790, 0xc790, gpLV},
+	{0xc791, 0xc7ab, gpLVT},
+	{0xc7ac, 0xc7ac, gpLV},
+	{0xc7ad, 0xc7c7, gpLVT},
+	{0xc7c8, 0xc7c8, gpLV},
+	{0xc7c9, 0xc7e3, gpLVT},
+	{0xc7e4, 0xc7e4, gpLV},
+	{0xc7e5, 0xc7ff, gpLVT},
+	{0xc800, 0xc800, gpLV},
+	{0xc801, 0xc81b, gpLVT},
+	{0xc81c, 0xc81c, gpLV},
+	{0xc81d, 0xc837, gpLVT},
+	{0xc838, 0xc838, gpLV},
+	{0xc839, 0xc853, gpLVT},
+	{0xc854, 0xc854, gpLV},
+	{0xc855, 0xc86f, gpLVT},
+	{0xc870, 0xc870, gpLV},
+	{0xc871, 0xc88b, gpLVT},
+	{0xc88c, 0xc88c, gpLV},
+	{0xc88d, 0xc8a7, gpLVT},
+	{0xc8a8, 0xc8a8, gpLV},
+	{0xc8a9, 0xc8c3, gpLVT},
+	{0xc8c4, 0xc8c4, gpLV},
+	{0xc8c5, 0xc8df, gpLVT},
+	{0xc8e0, 0xc8e0, gpLV},
+	{0xc8e1, 0xc8fb, gpLVT},
+	{0xc8fc, 0xc8fc, gpLV},
+	{0xc8fd, 0xc917, gpLVT},
+	{0xc918, 0xc918, gpLV},
+	{0xc919, 0xc933, gpLVT},
+	{0xc934, 0xc934, gpLV},
+	{0xc935, 0xc94f, gpLVT},
+	{0xc950, 0xc950, gpLV},
+	{0xc951, 0xc96b, gpLVT},
+	{0xc96c, 0xc96c, gpLV},
+	{0xc96d, 0xc987, gpLVT},
+	{0xc988, 0xc988, gpLV},
+	{0xc989, 0xc9a3, gpLVT},
+	{0xc9a4, 0xc9a4, gpLV},
+	{0xc9a5, 0xc9bf, gpLVT},
+	{0xc9c0, 0xc9c0, gpLV},
+	{0xc9c1, 0xc9db, gpLVT},
+	{0xc9dc, 0xc9dc, gpLV},
+	{0xc9dd, 0xc9f7, gpLVT},
+	{0xc9f8, 0xc9f8, gpLV},
+	{0xc9f9, 0xca13, gpLVT},
+	{0xca14, 0xca14, gpLV},
+	{0xca15, 0xca2f, gpLVT},
+	{0xca30, 0xca30, gpLV},
+	{0xca31, 0xca4b, gpLVT},
+	{0xca4c, 0xca4c, gpLV},
+	{0xca4d, 0xca67, gpLVT},
+	{0xca68, 0xca68, gpLV},
+	{0xca69, 0xca83, gpLVT},
+	{0xca84, 0xca84, gpLV},
+	{0xca85, 0xca9f, gpLVT},
+	{0xcaa0, 0xcaa0, gpLV},
+	{0xcaa1, 0xcabb, gpLVT},
+	{0xcabc, 0xcabc, gpLV},
+	{0xcabd, 0xcad7, gpLVT},
+	{0xcad8, 0xcad8, gpLV},
+	{0xcad9, 0xcaf3, gpLVT},
+	{0xcaf4, 0xcaf4, gpLV},
+	{0xcaf5, 0xcb0f, gpLVT},
+	{0xcb10, 0xcb10, gpLV},
+	{0xcb11, 0xcb2b, gpLVT},
+	{0xcb2c, 0xcb2c, gpLV},
+	{0xcb2d, 0xcb47, gpLVT},
+	{0xcb48, 0xcb48, gpLV},
+	{0xcb49, 0xcb63, gpLVT},
+	{0xcb64, 0xcb64, gpLV},
+	{0xcb65, 0xcb7f, gpLVT},
+	{0xcb80, 0xcb80, gpLV},
+	{0xcb81, 0xcb9b, gpLVT},
+	{0xcb9c, 0xcb9c, gpLV},
+	{0xcb9d, 0xcbb7, gpLVT},
+	{0xcbb8, 0xcbb8, gpLV},
+	{0xcbb9, 0xcbd3, gpLVT},
+	{0xcbd4, 0xcbd4, gpLV},
+	{0xcbd5, 0xcbef, gpLVT},
+	{0xcbf0, 0xcbf0, gpLV},
+	{0xcbf1, 0xcc0b, gpLVT},
+	{0xcc0c, 0xcc0c, gpLV},
+	{0xcc0d, 0xcc27, gpLVT},
+	{0xcc28, 0xcc28, gpLV},
+	{0xcc29, 0xcc43, gpLVT},
+	{0xcc44, 0xcc44, gpLV},
+	{0xcc45, 0xcc5f, gpLVT},
+	{0xcc60, 0xcc60, gpLV},
+	{0xcc61, 0xcc7b, gpLVT},
+	{0xcc7c, 0xcc7c, gpLV},
+	{0xcc7d, 0xcc97, gpLVT},
+	{0xcc98, 0xcc98, gpLV},
+	{0xcc99, 0xccb3, gpLVT},
+	{0xccb4, 0xccb4, gpLV},
+	{0xccb5, 0xcccf, gpLVT},
+	{0xccd0, 0xccd0, gpLV},
+	{0xccd1, 0xcceb, gpLVT},
+	{0xccec, 0xccec, gpLV},
+	{0xcced, 0xcd07, gpLVT},
+	{0xcd08, 0xcd08, gpLV},
+	{0xcd09, 0xcd23, gpLVT},
+	{0xcd24, 0xcd24, gpLV},
+	{0xcd25, 0xcd3f, gpLVT},
+	{0xcd40, 0xcd40, gpLV},
+	{0xcd41, 0xcd5b, gpLVT},
+	{0xcd5c, 0xcd5c, gpLV},
+	{0xcd5d, 0xcd77, gpLVT},
+	{0xcd78, 0xcd78, gpLV},
+	{0xcd79, 0xcd93, gpLVT},
+	{0xcd94, 0xcd94, gpLV},
+	{0xcd95, 0xcdaf, gpLVT},
+	{0xcdb0, 0xcdb0, gpLV},
+	{0xcdb1, 0xcdcb, gpLVT},
+	{0xcdcc, 0xcdcc, gpLV},
+	{0xcdcd, 0xcde7, gpLVT},
+	{0xcde8, 0xcde8, gpLV},
+	{0xcde9, 0xce03, gpLVT},
+	{0xce04, 0xce04, gpLV},
+	{0xce05, 0xce1f, gpLVT},
+	{0xce20, 0xce20, gpLV},
+	{0xce21, 0xce3b, gpLVT},
+	{0xce3c, 0xce3c, gpLV},
+	{0xce3d, 0xce57, gpLVT},
+	{0xce58, 0xce58, gpLV},
+	{0xce59, 0xce73, gpLVT},
+	{0xce74, 0xce74, gpLV},
+	{0xce75, 0xce8f, gpLVT},
+	{0xce90, 0xce90, gpLV},
+	{0xce91, 0xceab, gpLVT},
+	{0xceac, 0xceac, gpLV},
+	{0xcead, 0xcec7, gpLVT},
+	{0xcec8, 0xcec8, gpLV},
+	{0xcec9, 0xcee3, gpLVT},
+	{0xcee4, 0xcee4, gpLV},
+	{0xcee5, 0xceff, gpLVT},
+	{0xcf00, 0xcf00, gpLV},
+	{0xcf01, 0xcf1b, gpLVT},
+	{0xcf1c, 0xcf1c, gpLV},
+	{0xcf1d, 0xcf37, gpLVT},
+	{0xcf38, 0xcf38, gpLV},
+	{0xcf39, 0xcf53, gpLVT},
+	{0xcf54, 0xcf54, gpLV},
+	{0xcf55, 0xcf6f, gpLVT},
+	{0xcf70, 0xcf70, gpLV},
+	{0xcf71, 0xcf8b, gpLVT},
+	{0xcf8c, 0xcf8c, gpLV},
+	{0xcf8d, 0xcfa7, gpLVT},
+	{0xcfa8, 0xcfa8, gpLV},
+	{0xcfa9, 0xcfc3, gpLVT},
+	{0xcfc4, 0xcfc4, gpLV},
+	{0xcfc5, 0xcfdf, gpLVT},
+	{0xcfe0, 0xcfe0, gpLV},
+	{0xcfe1, 0xcffb, gpLVT},
+	{0xcffc, 0xcffc, gpLV},
+	{0xcffd, 0xd017, gpLVT},
+	{0xd018, 0xd018, gpLV},
+	{0xd019, 0xd033, gpLVT},
+	{0xd034, 0xd034, gpLV},
+	{0xd035, 0xd04f, gpLVT},
+	{0xd050, 0xd050, gpLV},
+	{0xd051, 0xd06b, gpLVT},
+	{0xd06c, 0xd06c, gpLV},
+	{0xd06d, 0xd087, gpLVT},
+	{0xd088, 0xd088, gpLV},
+	{0xd089, 0xd0a3, gpLVT},
+	{0xd0a4, 0xd0a4, gpLV},
+	{0xd0a5, 0xd0bf, gpLVT},
+	{0xd0c0, 0xd0c0, gpLV},
+	{0xd0c1, 0xd0db, gpLVT},
+	{0xd0dc, 0xd0dc, gpLV},
+	{0xd0dd, 0xd0f7, gpLVT},
+	{0xd0f8, 0xd0f8, gpLV},
+	{0xd0f9, 0xd113, gpLVT},
+	{0xd114, 0xd114, gpLV},
+	{0xd115, 0xd12f, gpLVT},
+	{0xd130, 0xd130, gpLV},
+	{0xd131, 0xd14b, gpLVT},
+	{0xd14c, 0xd14c, gpLV},
+	{0xd14d, 0xd167, gpLVT},
+	{0xd168, 0xd168, gpLV},
+	{0xd169, 0xd183, gpLVT},
+	{0xd184, 0xd184, gpLV},
+	{0xd185, 0xd19f, gpLVT},
+	{0xd1a0, 0xd1a0, gpLV},
+	{0xd1a1, 0xd1bb, gpLVT},
+	{0xd1bc, 0xd1bc, gpLV},
+	{0xd1bd, 0xd1d7, gpLVT},
+	{0xd1d8, 0xd1d8, gpLV},
+	{0xd1d9, 0xd1f3, gpLVT},
+	{0xd1f4, 0xd1f4, gpLV},
+	{0xd1f5, 0xd20f, gpLVT},
+	{0xd210, 0xd210, gpLV},
+	{0xd211, 0xd22b, gpLVT},
+	{0xd22c, 0xd22c, gpLV},
+	{0xd22d, 0xd247, gpLVT},
+	{0xd248, 0xd248, gpLV},
+	{0xd249, 0xd263, gpLVT},
+	{0xd264, 0xd264, gpLV},
+	{0xd265, 0xd27f, gpLVT},
+	{0xd280, 0xd280, gpLV},
+	{0xd281, 0xd29b, gpLVT},
+	{0xd29c, 0xd29c, gpLV},
+	{0xd29d, 0xd2b7, gpLVT},
+	{0xd2b8, 0xd2b8, gpLV},
+	{0xd2b9, 0xd2d3, gpLVT},
+	{0xd2d4, 0xd2d4, gpLV},
+	{0xd2d5, 0xd2ef, gpLVT},
+	{0xd2f0, 0xd2f0, gpLV},
+	{0xd2f1, 0xd30b, gpLVT},
+	{0xd30c, 0xd30c, gpLV},
+	{0xd30d, 0xd327, gpLVT},
+	{0xd328, 0xd328, gpLV},
+	{0xd329, 0xd343, gpLVT},
+	{0xd344, 0xd344, gpLV},
+	{0xd345, 0xd35f, gpLVT},
+	{0xd360, 0xd360, gpLV},
+	{0xd361, 0xd37b, gpLVT},
+	{0xd37c, 0xd37c, gpLV},
+	{0xd37d, 0xd397, gpLVT},
+	{0xd398, 0xd398, gpLV},
+	{0xd399, 0xd3b3, gpLVT},
+	{0xd3b4, 0xd3b4, gpLV},
+	{0xd3b5, 0xd3cf, gpLVT},
+	{0xd3d0, 0xd3d0, gpLV},
+	{0xd3d1, 0xd3eb, gpLVT},
+	{0xd3ec, 0xd3ec, gpLV},
+	{0xd3ed, 0xd407, gpLVT},
+	{0xd408, 0xd408, gpLV},
+	{0xd409, 0xd423, gpLVT},
+	{0xd424, 0xd424, gpLV},
+	{0xd425, 0xd43f, gpLVT},
+	{0xd440, 0xd440, gpLV},
+	{0xd441, 0xd45b, gpLVT},
+	{0xd45c, 0xd45c, gpLV},
+	{0xd45d, 0xd477, gpLVT},
+	{0xd478, 0xd478, gpLV},
+	{0xd479, 0xd493, gpLVT},
+	{0xd494, 0xd494, gpLV},
+	{0xd495, 0xd4af, gpLVT},
+	{0xd4b0, 0xd4b0, gpLV},
+	{0xd4b1, 0xd4cb, gpLVT},
+	{0xd4cc, 0xd4cc, gpLV},
+	{0xd4cd, 0xd4e7, gpLVT},
+	{0xd4e8, 0xd4e8, gpLV},
+	{0xd4e9, 0xd503, gpLVT},
+	{0xd504, 0xd504, gpLV},
+	{0xd505, 0xd51f, gpLVT},
+	{0xd520, 0xd520, gpLV},
+	{0xd521, 0xd53b, gpLVT},
+	{0xd53c, 0xd53c, gpLV},
+	{0xd53d, 0xd557, gpLVT},
+	{0xd558, 0xd558, gpLV},
+	{0xd559, 0xd573, gpLVT},
+	{0xd574, 0xd574, gpLV},
+	{0xd575, 0xd58f, gpLVT},
+	{0xd590, 0xd590, gpLV},
+	{0xd591, 0xd5ab, gpLVT},
+	{0xd5ac, 0xd5ac, gpLV},
+	{0xd5ad, 0xd5c7, gpLVT},
+	{0xd5c8, 0xd5c8, gpLV},
+	{0xd5c9, 0xd5e3, gpLVT},
+	{0xd5e4, 0xd5e4, gpLV},
+	{0xd5e5, 0xd5ff, gpLVT},
+	{0xd600, 0xd600, gpLV},
+	{0xd601, 0xd61b, gpLVT},
+	{0xd61c, 0xd61c, gpLV},
+	{0xd61d, 0xd637, gpLVT},
+	{0xd638, 0xd638, gpLV},
+	{0xd639, 0xd653, gpLVT},
+	{0xd654, 0xd654, gpLV},
+	{0xd655, 0xd66f, gpLVT},
+	{0xd670, 0xd670, gpLV},
+	{0xd671, 0xd68b, gpLVT},
+	{0xd68c, 0xd68c, gpLV},
+	{0xd68d, 0xd6a7, gpLVT},
+	{0xd6a8, 0xd6a8, gpLV},
+	{0xd6a9, 0xd6c3, gpLVT},
+	{0xd6c4, 0xd6c4, gpLV},
+	{0xd6c5, 0xd6df, gpLVT},
+	{0xd6e0, 0xd6e0, gpLV},
+	{0xd6e1, 0xd6fb, gpLVT},
+	{0xd6fc, 0xd6fc, gpLV},
+	{0xd6fd, 0xd717, gpLVT},
+	{0xd718, 0xd718, gpLV},
+	{0xd719, 0xd733, gpLVT},
+	{0xd734, 0xd734, gpLV},
+	{0xd735, 0xd74f, gpLVT},
+	{0xd750, 0xd750, gpLV},
+	{0xd751, 0xd76b, gpLVT},
+	{0xd76c, 0xd76c, gpLV},
+	{0xd76d, 0xd787, gpLVT},
+	{0xd788, 0xd788, gpLV},
+	{0xd789, 0xd7a3, gpLVT},
+	{0xd7b0, 0xd7c6, gpV},
+	{0xd7cb, 0xd7fb, gpT},
+	{0xfb1e, 0xfb1e, gpExtend},
+	{0xfe00, 0xfe0f, gpExtend},
+	{0xfe20, 0xfe2f, gpExtend},
+	{0xfeff, 0xfeff, gpControl},
+	{0xff9e, 0xff9f, gpExtend},
+	{0xfff0, 0xfffb, gpControl},
+	{0x101fd, 0x101fd, gpExtend},
+	{0x102e0, 0x102e0, gpExtend},
+	{0x10376, 0x1037a, gpExtend},
+	{0x10a01, 0x10a03, gpExtend},
+	{0x10a05, 0x10a06, gpExtend},
+	{0x10a0c, 0x10a0f, gpExtend},
+	{0x10a38, 0x10a3a, gpExtend},
+	{0x10a3f, 0x10a3f, gpExtend},
+	{0x10ae5, 0x10ae6, gpExtend},
+	{0x10d24, 0x10d27, gpExtend},
+	{0x10eab, 0x10eac, gpExtend},
+	{0x10f46, 0x10f50, gpExtend},
+	{0x10f82, 0x10f85, gpExtend},
+	{0x11000, 0x11000, gpSpacingMark},
+	{0x11001, 0x11001, gpExtend},
+	{0x11002, 0x11002, gpSpacingMark},
+	{0x11038, 0x11046, gpExtend},
+	{0x11070, 0x11070, gpExtend},
+	{0x11073, 0x11074, gpExtend},
+	{0x1107f, 0x11081, gpExtend},
+	{0x11082, 0x11082, gpSpacingMark},
+	{0x110b0, 0x110b2, gpSpacingMark},
+	{0x110b3, 0x110b6, gpExtend},
+	{0x110b7, 0x110b8, gpSpacingMark},
+	{0x110b9, 0x110ba, gpExtend},
+	{0x110bd, 0x110bd, gpPrepend},
+	{0x110c2, 0x110c2, gpExtend},
+	{0x110cd, 0x110cd, gpPrepend},
+	{0x11100, 0x11102, gpExtend},
+	{0x11127, 0x1112b, gpExtend},
+	{0x1112c, 0x1112c, gpSpacingMark},
+	{0x1112d, 0x11134, gpExtend},
+	{0x11145, 0x11146, gpSpacingMark},
+	{0x11173, 0x11173, gpExtend},
+	{0x11180, 0x11181, gpExtend},
+	{0x11182, 0x11182, gpSpacingMark},
+	{0x111b3, 0x111b5, gpSpacingMark},
+	{0x111b6, 0x111be, gpExtend},
+	{0x111bf, 0x111c0, gpSpacingMark},
+	{0x111c2, 0x111c3, gpPrepend},
+	{0x111c9, 0x111cc, gpExtend},
+	{0x111ce, 0x111ce, gpSpacingMark},
+	{0x111cf, 0x111cf, gpExtend},
+	{0x1122c, 0x1122e, gpSpacingMark},
+	{0x1122f, 0x11231, gpExtend},
+	{0x11232, 0x11233, gpSpacingMark},
+	{0x11234, 0x11234, gpExtend},
+	{0x11235, 0x11235, gpSpacingMark},
+	{0x11236, 0x11237, gpExtend},
+	{0x1123e, 0x1123e, gpExtend},
+	{0x112df, 0x112df, gpExtend},
+	{0x112e0, 0x112e2, gpSpacingMark},
+	{0x112e3, 0x112ea, gpExtend},
+	{0x11300, 0x11301, gpExtend},
+	{0x11302, 0x11303, gpSpacingMark},
+	{0x1133b, 0x1133c, gpExtend},
+	{0x1133e, 0x1133e, gpExtend},
+	{0x1133f, 0x1133f, gpSpacingMark},
+	{0x11340, 0x11340, gpExtend},
+	{0x11341, 0x11344, gpSpacingMark},
+	{0x11347, 0x11348, gpSpacingMark},
+	{0x1134b, 0x1134d, gpSpacingMark},
+	{0x11357, 0x11357, gpExtend},
+	{0x11362, 0x11363, gpSpacingMark},
+	{0x11366, 0x1136c, gpExtend},
+	{0x11370, 0x11374, gpExtend},
+	{0x11435, 0x11437, gpSpacingMark},
+	{0x11438, 0x1143f, gpExtend},
+	{0x11440, 0x11441, gpSpacingMark},
+	{0x11442, 0x11444, gpExtend},
+	{0x11445, 0x11445, gpSpacingMark},
+	{0x11446, 0x11446, gpExtend},
+	{0x1145e, 0x1145e, gpExtend},
+	{0x114b0, 0x114b0, gpExtend},
+	{0x114b1, 0x114b2, gpSpacingMark},
+	{0x114b3, 0x114b8, gpExtend},
+	{0x114b9, 0x114b9, gpSpacingMark},
+	{0x114ba, 0x114ba, gpExtend},
+	{0x114bb, 0x114bc, gpSpacingMark},
+	{0x114bd, 0x114bd, gpExtend},
+	{0x114be, 0x114be, gpSpacingMark},
+	{0x114bf, 0x114c0, gpExtend},
+	{0x114c1, 0x114c1, gpSpacingMark},
+	{0x114c2, 0x114c3, gpExtend},
+	{0x115af, 0x115af, gpExtend},
+	{0x115b0, 0x115b1, gpSpacingMark},
+	{0x115b2, 0x115b5, gpExtend},
+	{0x115b8, 0x115bb, gpSpacingMark},
+	{0x115bc, 0x115bd, gpExtend},
+	{0x115be, 0x115be, gpSpacingMark},
+	{0x115bf, 0x115c0, gpExtend},
+	{0x115dc, 0x115dd, gpExtend},
+	{0x11630, 0x11632, gpSpacingMark},
+	{0x11633, 0x1163a, gpExtend},
+	{0x1163b, 0x1163c, gpSpacingMark},
+	{0x1163d, 0x1163d, gpExtend},
+	{0x1163e, 0x1163e, gpSpacingMark},
+	{0x1163f, 0x11640, gpExtend},
+	{0x116ab, 0x116ab, gpExtend},
+	{0x116ac, 0x116ac, gpSpacingMark},
+	{0x116ad, 0x116ad, gpExtend},
+	{0x116ae, 0x116af, gpSpacingMark},
+	{0x116b0, 0x116b5, gpExtend},
+	{0x116b6, 0x116b6, gpSpacingMark},
+	{0x116b7, 0x116b7, gpExtend},
+	{0x1171d, 0x1171f, gpExtend},
+	{0x11722, 0x11725, gpExtend},
+	{0x11726, 0x11726, gpSpacingMark},
+	{0x11727, 0x1172b, gpExtend},
+	{0x1182c, 0x1182e, gpSpacingMark},
+	{0x1182f, 0x11837, gpExtend},
+	{0x11838, 0x11838, gpSpacingMark},
+	{0x11839, 0x1183a, gpExtend},
+	{0x11930, 0x11930, gpExtend},
+	{0x11931, 0x11935, gpSpacingMark},
+	{0x11937, 0x11938, gpSpacingMark},
+	{0x1193b, 0x1193c, gpExtend},
+	{0x1193d, 0x1193d, gpSpacingMark},
+	{0x1193e, 0x1193e, gpExtend},
+	{0x1193f, 0x1193f, gpPrepend},
+	{0x11940, 0x11940, gpSpacingMark},
+	{0x11941, 0x11941, gpPrepend},
+	{0x11942, 0x11942, gpSpacingMark},
+	{0x11943, 0x11943, gpExtend},
+	{0x119d1, 0x119d3, gpSpacingMark},
+	{0x119d4, 0x119d7, gpExtend},
+	{0x119da, 0x119db, gpExtend},
+	{0x119dc, 0x119df, gpSpacingMark},
+	{0x119e0, 0x119e0, gpExtend},
+	{0x119e4, 0x119e4, gpSpacingMark},
+	{0x11a01, 0x11a0a, gpExtend},
+	{0x11a33, 0x11a38, gpExtend},
+	{0x11a39, 0x11a39, gpSpacingMark},
+	{0x11a3a, 0x11a3a, gpPrepend},
+	{0x11a3b, 0x11a3e, gpExtend},
+	{0x11a47, 0x11a47, gpExtend},
+	{0x11a51, 0x11a56, gpExtend},
+	{0x11a57, 0x11a58, gpSpacingMark},
+	{0x11a59, 0x11a5b, gpExtend},
+	{0x11a84, 0x11a89, gpPrepend},
+	{0x11a8a, 0x11a96, gpExtend},
+	{0x11a97, 0x11a97, gpSpacingMark},
+	{0x11a98, 0x11a99, gpExtend},
+	{0x11c2f, 0x11c2f, gpSpacingMark},
+	{0x11c30, 0x11c36, gpExtend},
+	{0x11c38, 0x11c3d, gpExtend},
+	{0x11c3e, 0x11c3e, gpSpacingMark},
+	{0x11c3f, 0x11c3f, gpExtend},
+	{0x11c92, 0x11ca7, gpExtend},
+	{0x11ca9, 0x11ca9, gpSpacingMark},
+	{0x11caa, 0x11cb0, gpExtend},
+	{0x11cb1, 0x11cb1, gpSpacingMark},
+	{0x11cb2, 0x11cb3, gpExtend},
+	{0x11cb4, 0x11cb4, gpSpacingMark},
+	{0x11cb5, 0x11cb6, gpExtend},
+	{0x11d31, 0x11d36, gpExtend},
+	{0x11d3a, 0x11d3a, gpExtend},
+	{0x11d3c, 0x11d3d, gpExtend},
+	{0x11d3f, 0x11d45, gpExtend},
+	{0x11d46, 0x11d46, gpPrepend},
+	{0x11d47, 0x11d47, gpExtend},
+	{0x11d8a, 0x11d8e, gpSpacingMark},
+	{0x11d90, 0x11d91, gpExtend},
+	{0x11d93, 0x11d94, gpSpacingMark},
+	{0x11d95, 0x11d95, gpExtend},
+	{0x11d96, 0x11d96, gpSpacingMark},
+	{0x11d97, 0x11d97, gpExtend},
+	{0x11ef3, 0x11ef4, gpExtend},
+	{0x11ef5, 0x11ef6, gpSpacingMark},
+	{0x13430, 0x13438, gpControl},
+	{0x16af0, 0x16af4, gpExtend},
+	{0x16b30, 0x16b36, gpExtend},
+	{0x16f4f, 0x16f4f, gpExtend},
+	{0x16f51, 0x16f87, gpSpacingMark},
+	{0x16f8f, 0x16f92, gpExtend},
+	{0x16fe4, 0x16fe4, gpExtend},
+	{0x16ff0, 0x16ff1, gpSpacingMark},
+	{0x1bc9d, 0x1bc9e, gpExtend},
+	{0x1bca0, 0x1bca3, gpControl},
+	{0x1cf00, 0x1cf2d, gpExtend},
+	{0x1cf30, 0x1cf46, gpExtend},
+	{0x1d165, 0x1d165, gpExtend},
+	{0x1d166, 0x1d166, gpSpacingMark},
+	{0x1d167, 0x1d169, gpExtend},
+	{0x1d16d, 0x1d16d, gpSpacingMark},
+	{0x1d16e, 0x1d172, gpExtend},
+	{0x1d173, 0x1d17a, gpControl},
+	{0x1d17b, 0x1d182, gpExtend},
+	{0x1d185, 0x1d18b, gpExtend},
+	{0x1d1aa, 0x1d1ad, gpExtend},
+	{0x1d242, 0x1d244, gpExtend},
+	{0x1da00, 0x1da36, gpExtend},
+	{0x1da3b, 0x1da6c, gpExtend},
+	{0x1da75, 0x1da75, gpExtend},
+	{0x1da84, 0x1da84, gpExtend},
+	{0x1da9b, 0x1da9f, gpExtend},
+	{0x1daa1, 0x1daaf, gpExtend},
+	{0x1e000, 0x1e006, gpExtend},
+	{0x1e008, 0x1e018, gpExtend},
+	{0x1e01b, 0x1e021, gpExtend},
+	{0x1e023, 0x1e024, gpExtend},
+	{0x1e026, 0x1e02a, gpExtend},
+	{0x1e130, 0x1e136, gpExtend},
+	{0x1e2ae, 0x1e2ae, gpExtend},
+	{0x1e2ec, 0x1e2ef, gpExtend},
+	{0x1e8d0, 0x1e8d6, gpExtend},
+	{0x1e944, 0x1e94a, gpExtend},
+	{0x1f000, 0x1f0ff, gpExtendedPictographic},
+	{0x1f10d, 0x1f10f, gpExtendedPictographic},
+	{0x1f12f, 0x1f12f, gpExtendedPictographic},
+	{0x1f16c, 0x1f171, gpExtendedPictographic},
+	{0x1f17e, 0x1f17f, gpExtendedPictographic},
+	{0x1f18e, 0x1f18e, gpExtendedPictographic},
+	{0x1f191, 0x1f19a, gpExtendedPictographic},
+	{0x1f1ad, 0x1f1e5, gpExtendedPictographic},
+	{0x1f1e6, 0x1f1ff, gpRegionalIndicator},
+	{0x1f201, 0x1f20f, gpExtendedPictographic},
+	{0x1f21a, 0x1f21a, gpExtendedPictographic},
+	{0x1f22f, 0x1f22f, gpExtendedPictographic},
+	{0x1f232, 0x1f23a, gpExtendedPictographic},
+	{0x1f23c, 0x1f23f, gpExtendedPictographic},
+	{0x1f249, 0x1f3fa, gpExtendedPictographic},
+	{0x1f3fb, 0x1f3ff, gpExtend},
+	{0x1f400, 0x1f53d, gpExtendedPictographic},
+	{0x1f546, 0x1f64f, gpExtendedPictographic},
+	{0x1f680, 0x1f6ff, gpExtendedPictographic},
+	{0x1f774, 0x1f77f, gpExtendedPictographic},
+	{0x1f7d5, 0x1f7ff, gpExtendedPictographic},
+	{0x1f80c, 0x1f80f, gpExtendedPictographic},
+	{0x1f848, 0x1f84f, gpExtendedPictographic},
+	{0x1f85a, 0x1f85f, gpExtendedPictographic},
+	{0x1f888, 0x1f88f, gpExtendedPictographic},
+	{0x1f8ae, 0x1f8ff, gpExtendedPictographic},
+	{0x1f90c, 0x1f93a, gpExtendedPictographic},
+	{0x1f93c, 0x1f945, gpExtendedPictographic},
+	{0x1f947, 0x1faff, gpExtendedPictographic},
+	{0x1fc00, 0x1fffd, gpExtendedPictographic},
+	{0xe0000, 0xe001f, gpControl},
+	{0xe0020, 0xe007f, gpExtend},
+	{0xe0080, 0xe00ff, gpControl},
+	{0xe0100, 0xe01ef, gpExtend},
+	{0xe01f0, 0xe0fff, gpControl},
+}
+
+// eastAsianWideTable lists East Asian Width W and F characters.
+var eastAsianWideTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+		{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+		{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x2e99, Stride: 1},
+		{Lo: 0x2e9b, Hi: 0x2ef3, Stride: 1},
+		{Lo: 0x2f00, Hi: 0x2fd5, Stride: 1},
+		{Lo: 0x2ff0, Hi: 0x2ffb, Stride: 1},
+		{Lo: 0x3000, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+		{Lo: 0x3099, Hi: 0x30ff, Stride: 1},
+		{Lo: 0x3105, Hi: 0x312f, Stride: 1},
+		{Lo: 0x3131, Hi: 0x318e, Stride: 1},
+		{Lo: 0x3190, Hi: 0x31e3, Stride: 1},
+		{Lo: 0x31f0, Hi: 0x321e, Stride: 1},
+		{Lo: 0x3220, Hi: 0x3247, Stride: 1},
+		{Lo: 0x3250, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0xa48c, Stride: 1},
+		{Lo: 0xa490, Hi: 0xa4c6, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97c, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe52, Stride: 1},
+		{Lo: 0xfe54, Hi: 0xfe66, Stride: 1},
+		{Lo: 0xfe68, Hi: 0xfe6b, Stride: 1},
+		{Lo: 0xff01, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16fe0, Hi: 0x16fe4, Stride: 1},
+		{Lo: 0x16ff0, Hi: 0x16ff1, Stride: 1},
+		{Lo: 0x17000, Hi: 0x187f7, Stride: 1},
+		{Lo: 0x18800, Hi: 0x18cd5, Stride: 1},
+		{Lo: 0x18d00, Hi: 0x18d08, Stride: 1},
+		{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+		{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+		{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
+		{Lo: 0x1b000, Hi: 0x1b122, Stride: 1},
+		{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+		{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+		{Lo: 0x1b170, Hi: 0x1b2fb, Stride: 1},
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1f202, Stride: 1},
+		{Lo: 0x1f210, Hi: 0x1f23b, Stride: 1},
+		{Lo: 0x1f240, Hi: 0x1f248, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f260, Hi: 0x1f265, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f320, Stride: 1},
+		{Lo: 0x1f32d, Hi: 0x1f335, Stride: 1},
+		{Lo: 0x1f337, Hi: 0x1f37c, Stride: 1},
+		{Lo: 0x1f37e, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f3a0, Hi: 0x1f3ca, Stride: 1},
+		{Lo: 0x1f3cf, Hi: 0x1f3d3, Stride: 1},
+		{Lo: 0x1f3e0, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f4, Hi: 0x1f3f4, Stride: 1},
+		{Lo: 0x1f3f8, Hi: 0x1f43e, Stride: 1},
+		{Lo: 0x1f440, Hi: 0x1f440, Stride: 1},
+		{Lo: 0x1f442, Hi: 0x1f4fc, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f54b, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a4, Stride: 1},
+		{Lo: 0x1f5fb, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+		{Lo: 0x1f6d0, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6d7, Stride: 1},
+		{Lo: 0x1f6dd, Hi: 0x1f6df, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6ec, Stride: 1},
+		{Lo: 0x1f6f4, Hi: 0x1f6fc, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f7f0, Hi: 0x1f7f0, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1fa74, Stride: 1},
+		{Lo: 0x1fa78, Hi: 0x1fa7c, Stride: 1},
+		{Lo: 0x1fa80, Hi: 0x1fa86, Stride: 1},
+		{Lo: 0x1fa90, Hi: 0x1faac, Stride: 1},
+		{Lo: 0x1fab0, Hi: 0x1faba, Stride: 1},
+		{Lo: 0x1fac0, Hi: 0x1fac5, Stride: 1},
+		{Lo: 0x1fad0, Hi: 0x1fad9, Stride: 1},
+		{Lo: 0x1fae0, Hi: 0x1fae7, Stride: 1},
+		{Lo: 0x1faf0, Hi: 0x1faf6, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+	LatinOffset: 0,
+}
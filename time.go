@@ -7,6 +7,8 @@ import (
 // TimeValidator is a validator for time.Time.
 type TimeValidator struct {
 	*Validator[time.Time]
+	clock Clock
+	loc   *time.Location
 }
 
 func Time() *TimeValidator {
@@ -28,8 +30,7 @@ func (t *TimeValidator) After(target func() time.Time) *TimeValidator {
 // After add a validate whether input value is after target date.
 func (t *TimeValidator) AfterDate(target func() time.Time) *TimeValidator {
 	return t.AppendValidate(func(value time.Time) error {
-		tim := target()
-		if time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location()).After(time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location())) {
+		if t.date(value).After(t.date(target())) {
 			return nil
 		}
 		return ErrTooSmall
@@ -49,8 +50,7 @@ func (t *TimeValidator) EqOrAfter(target func() time.Time) *TimeValidator {
 // EqOrAfter add a validate whether input value is after or equal to target date.
 func (t *TimeValidator) EqOrAfterDate(target func() time.Time) *TimeValidator {
 	return t.AppendValidate(func(value time.Time) error {
-		tim := target()
-		if time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location()).After(time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location())) {
+		if t.date(target()).After(t.date(value)) {
 			return ErrTooSmall
 		}
 		return nil
@@ -70,8 +70,7 @@ func (t *TimeValidator) Before(target func() time.Time) *TimeValidator {
 // BeforeDate add a validate whether input value is before target date.
 func (t *TimeValidator) BeforeDate(target func() time.Time) *TimeValidator {
 	return t.AppendValidate(func(value time.Time) error {
-		tim := target()
-		if time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location()).Before(time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location())) {
+		if t.date(value).Before(t.date(target())) {
 			return nil
 		}
 		return ErrTooBig
@@ -91,8 +90,7 @@ func (t *TimeValidator) EqOrBefore(target func() time.Time) *TimeValidator {
 // EqOrBeforeDate add a validate whether input value is before or equal to target date.
 func (t *TimeValidator) EqOrBeforeDate(target func() time.Time) *TimeValidator {
 	return t.AppendValidate(func(value time.Time) error {
-		tim := target()
-		if time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location()).Before(time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location())) {
+		if t.date(target()).Before(t.date(value)) {
 			return ErrTooBig
 		}
 		return nil
@@ -119,8 +117,7 @@ func (t *TimeValidator) Equal(target func() time.Time) *TimeValidator {
 
 func (t *TimeValidator) EqualDate(target func() time.Time) *TimeValidator {
 	return t.AppendValidate(func(value time.Time) error {
-		tim := target()
-		if tim.Year() == value.Year() && tim.Month() == value.Month() && tim.Day() == value.Day() {
+		if DateOf(t.in(value)) == DateOf(t.in(target())) {
 			return nil
 		}
 		return ErrNotEqual
@@ -132,9 +129,19 @@ func (t *TimeValidator) AppendValidate(funcs ...Validate[time.Time]) *TimeValida
 	return t
 }
 
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (t *TimeValidator) AppendValidator(inner ValueValidator[time.Time]) *TimeValidator {
+	t.Validator = t.Validator.AppendValidator(inner)
+	return t
+}
+
 // PointerTimeValidator is a validator for *time.Time.
+// Required, After, AfterDate, Before, BeforeDate, Equal and EqualDate reject nil input,
+// and the other rules pass it.
 type PointerTimeValidator struct {
 	*Validator[*time.Time]
+	clock Clock
+	loc   *time.Location
 }
 
 func PointerTime() *PointerTimeValidator {
@@ -156,8 +163,7 @@ func (t *PointerTimeValidator) After(target func() time.Time) *PointerTimeValida
 // After add a validate whether input value is after target date.
 func (t *PointerTimeValidator) AfterDate(target func() time.Time) *PointerTimeValidator {
 	return t.AppendValidate(func(value *time.Time) error {
-		tim := target()
-		if value != nil && time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location()).After(time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location())) {
+		if value != nil && t.date(*value).After(t.date(target())) {
 			return nil
 		}
 		return ErrTooSmall
@@ -177,8 +183,7 @@ func (t *PointerTimeValidator) EqOrAfter(target func() time.Time) *PointerTimeVa
 // EqOrAfter add a validate whether input value is after or equal to target date.
 func (t *PointerTimeValidator) EqOrAfterDate(target func() time.Time) *PointerTimeValidator {
 	return t.AppendValidate(func(value *time.Time) error {
-		tim := target()
-		if value != nil && time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location()).After(time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location())) {
+		if value != nil && t.date(target()).After(t.date(*value)) {
 			return ErrTooSmall
 		}
 		return nil
@@ -198,8 +203,7 @@ func (t *PointerTimeValidator) Before(target func() time.Time) *PointerTimeValid
 // BeforeDate add a validate whether input value is before target date.
 func (t *PointerTimeValidator) BeforeDate(target func() time.Time) *PointerTimeValidator {
 	return t.AppendValidate(func(value *time.Time) error {
-		tim := target()
-		if value != nil && time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location()).Before(time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location())) {
+		if value != nil && t.date(*value).Before(t.date(target())) {
 			return nil
 		}
 		return ErrTooBig
@@ -219,8 +223,7 @@ func (t *PointerTimeValidator) EqOrBefore(target func() time.Time) *PointerTimeV
 // EqOrBeforeDate add a validate whether input value is before or equal to target date.
 func (t *PointerTimeValidator) EqOrBeforeDate(target func() time.Time) *PointerTimeValidator {
 	return t.AppendValidate(func(value *time.Time) error {
-		tim := target()
-		if value != nil && time.Date(tim.Year(), tim.Month(), tim.Day(), 0, 0, 0, 0, tim.Location()).Before(time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location())) {
+		if value != nil && t.date(target()).Before(t.date(*value)) {
 			return ErrTooBig
 		}
 		return nil
@@ -247,8 +250,7 @@ func (t *PointerTimeValidator) Equal(target func() time.Time) *PointerTimeValida
 
 func (t *PointerTimeValidator) EqualDate(target func() time.Time) *PointerTimeValidator {
 	return t.AppendValidate(func(value *time.Time) error {
-		tim := target()
-		if value != nil && tim.Year() == value.Year() && tim.Month() == value.Month() && tim.Day() == value.Day() {
+		if value != nil && DateOf(t.in(*value)) == DateOf(t.in(target())) {
 			return nil
 		}
 		return ErrNotEqual
@@ -259,3 +261,9 @@ func (t *PointerTimeValidator) AppendValidate(funcs ...Validate[*time.Time]) *Po
 	t.Validator = t.Validator.AppendValidate(funcs...)
 	return t
 }
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (t *PointerTimeValidator) AppendValidator(inner ValueValidator[*time.Time]) *PointerTimeValidator {
+	t.Validator = t.Validator.AppendValidator(inner)
+	return t
+}
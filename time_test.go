@@ -434,6 +434,62 @@ func TestPointerTime_Validate(t *testing.T) {
 			},
 			svalidator.ErrEmpty,
 		},
+		{
+			"nil is after error",
+			args{
+				validator: svalidator.PointerTime().After(now),
+				input:     nil,
+			},
+			svalidator.ErrTooSmall,
+		},
+		{
+			"nil is after date error",
+			args{
+				validator: svalidator.PointerTime().AfterDate(now),
+				input:     nil,
+			},
+			svalidator.ErrTooSmall,
+		},
+		{
+			"nil is before error",
+			args{
+				validator: svalidator.PointerTime().Before(now),
+				input:     nil,
+			},
+			svalidator.ErrTooBig,
+		},
+		{
+			"nil is before date error",
+			args{
+				validator: svalidator.PointerTime().BeforeDate(now),
+				input:     nil,
+			},
+			svalidator.ErrTooBig,
+		},
+		{
+			"nil is equal error",
+			args{
+				validator: svalidator.PointerTime().Equal(now),
+				input:     nil,
+			},
+			svalidator.ErrNotEqual,
+		},
+		{
+			"nil is equal date error",
+			args{
+				validator: svalidator.PointerTime().EqualDate(now),
+				input:     nil,
+			},
+			svalidator.ErrNotEqual,
+		},
+		{
+			"skip nil",
+			args{
+				validator: svalidator.PointerTime().EqOrAfter(now).EqOrAfterDate(now).EqOrBefore(now).EqOrBeforeDate(now),
+				input:     nil,
+			},
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,180 @@
+package svalidator
+
+import (
+	"time"
+)
+
+// TimeOfDay is a civil time of day without date and location.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf returns the time of day of t in the location of t.
+// Use t.In(loc) to take the time of day in another location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	h, m, s := t.Clock()
+	return TimeOfDay{Hour: h, Minute: m, Second: s, Nanosecond: t.Nanosecond()}
+}
+
+// ParseTimeOfDay parses a time of day in "15:04" or "15:04:05" format.
+// Seconds may have a fraction such as "15:04:05.999".
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return TimeOfDayOf(t), nil
+		}
+	}
+	return TimeOfDay{}, ErrInvalidTimeOfDay
+}
+
+// String returns the time of day in "15:04:05" format with a fraction of second if any.
+func (t TimeOfDay) String() string {
+	return t.On(Date{Year: 2000, Month: time.January, Day: 1}, time.UTC).Format("15:04:05.999999999")
+}
+
+// IsValid reports whether every field of t is in its range.
+func (t TimeOfDay) IsValid() bool {
+	return 0 <= t.Hour && t.Hour < 24 &&
+		0 <= t.Minute && t.Minute < 60 &&
+		0 <= t.Second && t.Second < 60 &&
+		0 <= t.Nanosecond && t.Nanosecond < int(time.Second)
+}
+
+// SinceMidnight returns the duration from midnight to t.
+func (t TimeOfDay) SinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// On returns the time at t on date d in loc.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// Compare returns -1 if t is before u, 1 if t is after u and 0 if they are the same time of day.
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	switch a, b := t.SinceMidnight(), u.SinceMidnight(); {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Before reports whether t is before u.
+func (t TimeOfDay) Before(u TimeOfDay) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether t is after u.
+func (t TimeOfDay) After(u TimeOfDay) bool {
+	return t.Compare(u) > 0
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	tod, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+	*t = tod
+	return nil
+}
+
+// TimeOfDayValidator is a validator for TimeOfDay.
+// Input with a field out of its range fails with ErrInvalidTimeOfDay.
+type TimeOfDayValidator struct {
+	*Validator[TimeOfDay]
+}
+
+func CivilTimeOfDay() *TimeOfDayValidator {
+	return &TimeOfDayValidator{
+		Validator: New(func(value TimeOfDay) error {
+			if !value.IsValid() {
+				return ErrInvalidTimeOfDay
+			}
+			return nil
+		}),
+	}
+}
+
+// After adds a validate whether input is after target.
+func (t *TimeOfDayValidator) After(target TimeOfDay) *TimeOfDayValidator {
+	return t.AppendValidate(func(value TimeOfDay) error {
+		if value.After(target) {
+			return nil
+		}
+		return ErrTooSmall
+	})
+}
+
+// EqOrAfter adds a validate whether input is after or equal to target.
+func (t *TimeOfDayValidator) EqOrAfter(target TimeOfDay) *TimeOfDayValidator {
+	return t.AppendValidate(func(value TimeOfDay) error {
+		if value.Before(target) {
+			return ErrTooSmall
+		}
+		return nil
+	})
+}
+
+// Before adds a validate whether input is before target.
+func (t *TimeOfDayValidator) Before(target TimeOfDay) *TimeOfDayValidator {
+	return t.AppendValidate(func(value TimeOfDay) error {
+		if value.Before(target) {
+			return nil
+		}
+		return ErrTooBig
+	})
+}
+
+// EqOrBefore adds a validate whether input is before or equal to target.
+func (t *TimeOfDayValidator) EqOrBefore(target TimeOfDay) *TimeOfDayValidator {
+	return t.AppendValidate(func(value TimeOfDay) error {
+		if value.After(target) {
+			return ErrTooBig
+		}
+		return nil
+	})
+}
+
+// Equal adds a validate whether input is target.
+func (t *TimeOfDayValidator) Equal(target TimeOfDay) *TimeOfDayValidator {
+	return t.AppendValidate(func(value TimeOfDay) error {
+		if value.Compare(target) != 0 {
+			return ErrNotEqual
+		}
+		return nil
+	})
+}
+
+// Between adds a validate whether input is between from and to inclusive.
+// to may be before from for a range over midnight.
+func (t *TimeOfDayValidator) Between(from, to TimeOfDay) *TimeOfDayValidator {
+	return t.AppendValidate(func(value TimeOfDay) error {
+		if !inTimeRange(from.SinceMidnight(), to.SinceMidnight(), value.SinceMidnight()) {
+			return ErrOutOfTimeRange
+		}
+		return nil
+	})
+}
+
+func (t *TimeOfDayValidator) AppendValidate(funcs ...Validate[TimeOfDay]) *TimeOfDayValidator {
+	t.Validator = t.Validator.AppendValidate(funcs...)
+	return t
+}
+
+// AppendValidator appends inner as a rule, such as a validator of InGroup.
+func (t *TimeOfDayValidator) AppendValidator(inner ValueValidator[TimeOfDay]) *TimeOfDayValidator {
+	t.Validator = t.Validator.AppendValidator(inner)
+	return t
+}
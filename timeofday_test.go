@@ -0,0 +1,84 @@
+package svalidator_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestTimeOfDay(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		want  svalidator.TimeOfDay
+		str   string
+		err   error
+	}{
+		{"09:30", svalidator.TimeOfDay{Hour: 9, Minute: 30}, "09:30:00", nil},
+		{"23:59:59", svalidator.TimeOfDay{Hour: 23, Minute: 59, Second: 59}, "23:59:59", nil},
+		{"12:00:00.25", svalidator.TimeOfDay{Hour: 12, Nanosecond: 250000000}, "12:00:00.25", nil},
+		{"24:00", svalidator.TimeOfDay{}, "00:00:00", svalidator.ErrInvalidTimeOfDay},
+		{"9:3", svalidator.TimeOfDay{}, "00:00:00", svalidator.ErrInvalidTimeOfDay},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := svalidator.ParseTimeOfDay(tt.input)
+			assertError(t, tt.err, err)
+			if got != tt.want || got.String() != tt.str {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	tod := svalidator.TimeOfDay{Hour: 9}
+	at := tod.On(svalidator.Date{Year: 2024, Month: time.June, Day: 1}, tokyo)
+	if !at.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("on is %s", at)
+	}
+	if got := svalidator.TimeOfDayOf(at.UTC().In(tokyo)); got != tod {
+		t.Errorf("time of day of is %s", got)
+	}
+
+	var v struct{ Open svalidator.TimeOfDay }
+	assertError(t, nil, json.Unmarshal([]byte(`{"Open":"10:00"}`), &v))
+	b, err := json.Marshal(v)
+	assertError(t, nil, err)
+	if string(b) != `{"Open":"10:00:00"}` {
+		t.Errorf("json is %s", b)
+	}
+}
+
+func TestTimeOfDay_Validate(t *testing.T) {
+	nine := svalidator.TimeOfDay{Hour: 9}
+	type (
+		args struct {
+			validator *svalidator.TimeOfDayValidator
+			input     svalidator.TimeOfDay
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"midnight", args{svalidator.CivilTimeOfDay(), svalidator.TimeOfDay{}}, nil},
+		{"invalid", args{svalidator.CivilTimeOfDay(), svalidator.TimeOfDay{Minute: 60}}, svalidator.ErrInvalidTimeOfDay},
+		{"after", args{svalidator.CivilTimeOfDay().After(nine), svalidator.TimeOfDay{Hour: 9, Nanosecond: 1}}, nil},
+		{"after error", args{svalidator.CivilTimeOfDay().After(nine), nine}, svalidator.ErrTooSmall},
+		{"eq or after", args{svalidator.CivilTimeOfDay().EqOrAfter(nine), nine}, nil},
+		{"before error", args{svalidator.CivilTimeOfDay().Before(nine), nine}, svalidator.ErrTooBig},
+		{"eq or before error", args{svalidator.CivilTimeOfDay().EqOrBefore(nine), svalidator.TimeOfDay{Hour: 10}}, svalidator.ErrTooBig},
+		{"equal", args{svalidator.CivilTimeOfDay().Equal(nine), nine}, nil},
+		{"equal error", args{svalidator.CivilTimeOfDay().Equal(nine), svalidator.TimeOfDay{Hour: 21}}, svalidator.ErrNotEqual},
+		{"between", args{svalidator.CivilTimeOfDay().Between(nine, svalidator.TimeOfDay{Hour: 18}), svalidator.TimeOfDay{Hour: 18}}, nil},
+		{"between error", args{svalidator.CivilTimeOfDay().Between(nine, svalidator.TimeOfDay{Hour: 18}), svalidator.TimeOfDay{Hour: 18, Second: 1}}, svalidator.ErrOutOfTimeRange},
+		{"between over midnight", args{svalidator.CivilTimeOfDay().Between(svalidator.TimeOfDay{Hour: 22}, svalidator.TimeOfDay{Hour: 2}), svalidator.TimeOfDay{Hour: 1}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
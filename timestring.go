@@ -0,0 +1,240 @@
+package svalidator
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	_ "time/tzdata" // TimeZone does not depend on the zoneinfo of the host.
+)
+
+// ParseTime adds a validate whether input can be parsed by time.Parse with layout,
+// and then validates the parsed time by v. If v is nil, only the parse is checked.
+func (s *UStringValidator[T]) ParseTime(layout string, v ValueValidator[time.Time]) *UStringValidator[T] {
+	s.Validator.groups = append(s.Validator.groups, groupsOf(v)...)
+	return s.checkContext(parseTime(layout, v))
+}
+
+// RFC3339 adds a validate whether input is an RFC 3339 timestamp,
+// and then validates the parsed time by v. If v is nil, only the parse is checked.
+func (s *UStringValidator[T]) RFC3339(v ValueValidator[time.Time]) *UStringValidator[T] {
+	return s.ParseTime(time.RFC3339Nano, v)
+}
+
+// ISODuration adds a validate whether input is an ISO 8601 duration such as "P1DT2H",
+// and then validates the parsed duration by v. If v is nil, only the parse is checked.
+// See ParseISODuration for the accepted format.
+func (s *UStringValidator[T]) ISODuration(v ValueValidator[time.Duration]) *UStringValidator[T] {
+	s.Validator.groups = append(s.Validator.groups, groupsOf(v)...)
+	return s.checkContext(isoDuration(v))
+}
+
+// TimeZone adds a validate whether input is an IANA time zone name such as "Asia/Tokyo".
+func (s *UStringValidator[T]) TimeZone() *UStringValidator[T] {
+	return s.format(isTimeZone, ErrInvalidTimeZone)
+}
+
+func (s *UStringValidator[T]) checkContext(check func(context.Context, string) error) *UStringValidator[T] {
+	s.Validator = s.Validator.AppendContextValidate(func(ctx context.Context, value T) error {
+		return check(ctx, string(value))
+	})
+	return s
+}
+
+// ParseTime adds a validate whether input can be parsed by time.Parse with layout,
+// and then validates the parsed time by v. If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) ParseTime(layout string, v ValueValidator[time.Time]) *PointerUStringValidator[T] {
+	s.Validator.groups = append(s.Validator.groups, groupsOf(v)...)
+	return s.checkContext(parseTime(layout, v))
+}
+
+// RFC3339 adds a validate whether input is an RFC 3339 timestamp,
+// and then validates the parsed time by v. If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) RFC3339(v ValueValidator[time.Time]) *PointerUStringValidator[T] {
+	return s.ParseTime(time.RFC3339Nano, v)
+}
+
+// ISODuration adds a validate whether input is an ISO 8601 duration,
+// and then validates the parsed duration by v. If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) ISODuration(v ValueValidator[time.Duration]) *PointerUStringValidator[T] {
+	s.Validator.groups = append(s.Validator.groups, groupsOf(v)...)
+	return s.checkContext(isoDuration(v))
+}
+
+// TimeZone adds a validate whether input is an IANA time zone name.
+// If input is nil, returns nil.
+func (s *PointerUStringValidator[T]) TimeZone() *PointerUStringValidator[T] {
+	return s.format(isTimeZone, ErrInvalidTimeZone)
+}
+
+func (s *PointerUStringValidator[T]) checkContext(check func(context.Context, string) error) *PointerUStringValidator[T] {
+	s.Validator = s.Validator.AppendContextValidate(func(ctx context.Context, value *T) error {
+		if value == nil {
+			return nil
+		}
+		return check(ctx, string(*value))
+	})
+	return s
+}
+
+func parseTime(layout string, v ValueValidator[time.Time]) func(context.Context, string) error {
+	return func(ctx context.Context, str string) error {
+		t, err := time.Parse(layout, str)
+		if err != nil {
+			return ErrInvalidTime
+		}
+		if v == nil {
+			return nil
+		}
+		return unwrapValidate(validateWith(ctx, v, t))
+	}
+}
+
+func isoDuration(v ValueValidator[time.Duration]) func(context.Context, string) error {
+	return func(ctx context.Context, str string) error {
+		d, err := ParseISODuration(str)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			return nil
+		}
+		return unwrapValidate(validateWith(ctx, v, d))
+	}
+}
+
+// timeZones caches whether a name is a time zone, because LoadLocation reads the zoneinfo every time.
+// Invalid names are cached up to maxTimeZoneMisses, since they come from input.
+var (
+	timeZones      sync.Map
+	timeZoneMisses atomic.Int64
+)
+
+const maxTimeZoneMisses = 1024
+
+func isTimeZone(s string) bool {
+	// LoadLocation accepts "" and "Local", which are not zone names.
+	if s == "" || s == "Local" {
+		return false
+	}
+	if ok, cached := timeZones.Load(s); cached {
+		return ok.(bool)
+	}
+	_, err := time.LoadLocation(s)
+	ok := err == nil
+	if ok || timeZoneMisses.Add(1) <= maxTimeZoneMisses {
+		timeZones.Store(s, ok)
+	}
+	return ok
+}
+
+const (
+	nominalDay   = 24 * time.Hour
+	nominalWeek  = 7 * nominalDay
+	nominalMonth = 30 * nominalDay
+	nominalYear  = 365 * nominalDay
+)
+
+// ParseISODuration parses an ISO 8601 duration such as "P1Y2M3DT4H5M6.5S" or "P2W".
+// A leading "-" makes the duration negative, and only the last component may have a fraction.
+// Years, months and days have no fixed length, so they are converted as 365, 30 and 1 nominal days of 24 hours.
+func ParseISODuration(s string) (time.Duration, error) {
+	neg := strings.HasPrefix(s, "-")
+	rest := strings.TrimPrefix(s, "-")
+	if !strings.HasPrefix(rest, "P") {
+		return 0, ErrInvalidDuration
+	}
+	rest = rest[1:]
+
+	var (
+		total   time.Duration
+		units   = "YMWD"
+		inTime  bool
+		hasPart bool
+		hasFrac bool
+	)
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, ErrInvalidDuration
+			}
+			inTime, units, rest = true, "HMS", rest[1:]
+			if rest == "" {
+				return 0, ErrInvalidDuration
+			}
+			continue
+		}
+		if hasFrac {
+			return 0, ErrInvalidDuration
+		}
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		intPart, frac := rest[:i], ""
+		if i < len(rest) && (rest[i] == '.' || rest[i] == ',') {
+			j := i + 1
+			for j < len(rest) && rest[j] >= '0' && rest[j] <= '9' {
+				j++
+			}
+			frac, i = rest[i+1:j], j
+			if frac == "" {
+				return 0, ErrInvalidDuration
+			}
+		}
+		if intPart == "" || i == len(rest) {
+			return 0, ErrInvalidDuration
+		}
+		k := strings.IndexByte(units, rest[i])
+		if k < 0 {
+			return 0, ErrInvalidDuration
+		}
+		unit := isoUnit(inTime, units[k])
+		n, err := strconv.ParseInt(intPart, 10, 64)
+		if err != nil || n > int64(math.MaxInt64/unit) {
+			return 0, ErrInvalidDuration
+		}
+		d := time.Duration(n) * unit
+		if frac != "" {
+			f, _ := strconv.ParseFloat("0."+frac, 64)
+			d += time.Duration(math.Round(f * float64(unit)))
+		}
+		if d < 0 || total > math.MaxInt64-d {
+			return 0, ErrInvalidDuration
+		}
+		total += d
+		hasPart, hasFrac = true, frac != ""
+		units, rest = units[k+1:], rest[i+1:]
+	}
+	if !hasPart {
+		return 0, ErrInvalidDuration
+	}
+	if neg {
+		total = -total
+	}
+	return total, nil
+}
+
+func isoUnit(inTime bool, designator byte) time.Duration {
+	if inTime {
+		switch designator {
+		case 'H':
+			return time.Hour
+		case 'M':
+			return time.Minute
+		}
+		return time.Second
+	}
+	switch designator {
+	case 'Y':
+		return nominalYear
+	case 'M':
+		return nominalMonth
+	case 'W':
+		return nominalWeek
+	}
+	return nominalDay
+}
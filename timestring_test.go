@@ -0,0 +1,99 @@
+package svalidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestUString_TimeString(t *testing.T) {
+	y2k := func() time.Time { return time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC) }
+	type (
+		args struct {
+			validator *svalidator.StringValidator
+			input     string
+		}
+	)
+	for _, tt := range []struct {
+		name string
+		args args
+		err  error
+	}{
+		{"rfc3339", args{svalidator.String().RFC3339(nil), "2024-06-01T09:00:00+09:00"}, nil},
+		{"rfc3339 fraction", args{svalidator.String().RFC3339(nil), "2024-06-01T09:00:00.123Z"}, nil},
+		{"rfc3339 error", args{svalidator.String().RFC3339(nil), "2024-06-01 09:00:00"}, svalidator.ErrInvalidTime},
+		{"date layout", args{svalidator.String().ParseTime(time.DateOnly, nil), "2024-02-29"}, nil},
+		{"date layout error", args{svalidator.String().ParseTime(time.DateOnly, nil), "2023-02-29"}, svalidator.ErrInvalidTime},
+		{"time rules", args{svalidator.String().ParseTime(time.DateOnly, svalidator.Time().After(y2k)), "2024-02-29"}, nil},
+		{"time rules error", args{svalidator.String().ParseTime(time.DateOnly, svalidator.Time().After(y2k)), "1999-12-31"}, svalidator.ErrTooSmall},
+		{"iso duration", args{svalidator.String().ISODuration(nil), "P1DT2H"}, nil},
+		{"iso duration error", args{svalidator.String().ISODuration(nil), "1D"}, svalidator.ErrInvalidDuration},
+		{"iso duration max", args{svalidator.String().ISODuration(svalidator.Number[time.Duration]().Max(24 * time.Hour)), "PT24H"}, nil},
+		{"iso duration max error", args{svalidator.String().ISODuration(svalidator.Number[time.Duration]().Max(24 * time.Hour)), "P1DT1S"}, svalidator.ErrTooBig},
+		{"iso duration min error", args{svalidator.String().ISODuration(svalidator.Number[time.Duration]().Min(time.Minute)), "PT59S"}, svalidator.ErrTooSmall},
+		{"time zone", args{svalidator.String().TimeZone(), "Asia/Tokyo"}, nil},
+		{"time zone utc", args{svalidator.String().TimeZone(), "UTC"}, nil},
+		{"time zone error", args{svalidator.String().TimeZone(), "Asia/Osaka"}, svalidator.ErrInvalidTimeZone},
+		{"time zone cached", args{svalidator.String().TimeZone(), "Asia/Tokyo"}, nil},
+		{"time zone cached error", args{svalidator.String().TimeZone(), "Asia/Osaka"}, svalidator.ErrInvalidTimeZone},
+		{"time zone local", args{svalidator.String().TimeZone(), "Local"}, svalidator.ErrInvalidTimeZone},
+		{"time zone empty", args{svalidator.String().TimeZone(), ""}, svalidator.ErrInvalidTimeZone},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.err, err)
+		})
+	}
+}
+
+func TestUString_TimeStringContext(t *testing.T) {
+	v := svalidator.String().RFC3339(svalidator.Time().NotInFuture())
+	ctx := svalidator.WithClock(context.Background(), svalidator.NewFakeClock(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+	assertError(t, nil, v.ValidateContext(ctx, "2024-05-31T23:59:59Z"))
+	assertError(t, svalidator.ErrTooBig, v.ValidateContext(ctx, "2024-06-01T00:00:01Z"))
+}
+
+func TestPointerUString_TimeString(t *testing.T) {
+	v := svalidator.PointerString().RFC3339(nil).ISODuration(nil).TimeZone()
+	assertError(t, nil, v.Validate(nil))
+	assertError(t, svalidator.ErrInvalidTime, v.Validate(pointer("P1D")))
+}
+
+func TestParseISODuration(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		want  time.Duration
+		err   error
+	}{
+		{"P1DT2H", 26 * time.Hour, nil},
+		{"PT1H30M", 90 * time.Minute, nil},
+		{"PT0.5S", 500 * time.Millisecond, nil},
+		{"PT1,5M", 90 * time.Second, nil},
+		{"P2W", 14 * 24 * time.Hour, nil},
+		{"P1Y", 365 * 24 * time.Hour, nil},
+		{"P1M", 30 * 24 * time.Hour, nil},
+		{"-PT1M", -time.Minute, nil},
+		{"PT0S", 0, nil},
+		{"P", 0, svalidator.ErrInvalidDuration},
+		{"PT", 0, svalidator.ErrInvalidDuration},
+		{"P1DT", 0, svalidator.ErrInvalidDuration},
+		{"P1H", 0, svalidator.ErrInvalidDuration},
+		{"PT1D", 0, svalidator.ErrInvalidDuration},
+		{"PT1S1M", 0, svalidator.ErrInvalidDuration},
+		{"PT0.5M1S", 0, svalidator.ErrInvalidDuration},
+		{"PT.5S", 0, svalidator.ErrInvalidDuration},
+		{"PT1.S", 0, svalidator.ErrInvalidDuration},
+		{"P-1D", 0, svalidator.ErrInvalidDuration},
+		{"P1000Y", 0, svalidator.ErrInvalidDuration},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := svalidator.ParseISODuration(tt.input)
+			assertError(t, tt.err, err)
+			if got != tt.want {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
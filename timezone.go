@@ -0,0 +1,156 @@
+package svalidator
+
+import "time"
+
+// In sets the location in which date based rules such as AfterDate, Weekday and YearBetween are evaluated.
+// By default each time is evaluated in its own location, and AfterDate, BeforeDate and their EqOr variants
+// compare the midnights of input and target as instants, so their dates in different locations may not be compared by calendar.
+// With In, they compare the calendar dates in loc.
+func (t *TimeValidator) In(loc *time.Location) *TimeValidator {
+	t.loc = loc
+	return t
+}
+
+// RequireLocation adds a validate whether the location of input is loc.
+// Locations are compared by name.
+func (t *TimeValidator) RequireLocation(loc *time.Location) *TimeValidator {
+	return t.AppendValidate(requireLocation(loc))
+}
+
+// RequireUTC adds a validate whether the location of input is UTC.
+func (t *TimeValidator) RequireUTC() *TimeValidator {
+	return t.AppendValidate(requireLocation(time.UTC))
+}
+
+// Truncated adds a validate whether input is a multiple of d since the zero time.
+// For example, Truncated(time.Minute) requires a whole minute.
+func (t *TimeValidator) Truncated(d time.Duration) *TimeValidator {
+	return t.AppendValidate(truncated(d))
+}
+
+// NoMonotonic adds a validate whether the monotonic clock reading of input has been stripped.
+func (t *TimeValidator) NoMonotonic() *TimeValidator {
+	return t.AppendValidate(noMonotonic)
+}
+
+// YearBetween adds a validate whether the year of input is between min and max inclusive.
+func (t *TimeValidator) YearBetween(min, max int) *TimeValidator {
+	return t.AppendValidate(t.inLocation(yearBetween(min, max)))
+}
+
+func (t *TimeValidator) in(value time.Time) time.Time {
+	return inLocation(value, t.loc)
+}
+
+func (t *TimeValidator) date(value time.Time) time.Time {
+	return dateOf(value, t.loc)
+}
+
+func (t *TimeValidator) inLocation(f Validate[time.Time]) Validate[time.Time] {
+	return func(value time.Time) error {
+		return f(t.in(value))
+	}
+}
+
+// In sets the location in which date based rules such as AfterDate, Weekday and YearBetween are evaluated.
+// See TimeValidator.In for the default.
+func (t *PointerTimeValidator) In(loc *time.Location) *PointerTimeValidator {
+	t.loc = loc
+	return t
+}
+
+// RequireLocation adds a validate whether the location of input is loc.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) RequireLocation(loc *time.Location) *PointerTimeValidator {
+	return t.AppendValidate(skipNil(requireLocation(loc)))
+}
+
+// RequireUTC adds a validate whether the location of input is UTC.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) RequireUTC() *PointerTimeValidator {
+	return t.AppendValidate(skipNil(requireLocation(time.UTC)))
+}
+
+// Truncated adds a validate whether input is a multiple of d since the zero time.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) Truncated(d time.Duration) *PointerTimeValidator {
+	return t.AppendValidate(skipNil(truncated(d)))
+}
+
+// NoMonotonic adds a validate whether the monotonic clock reading of input has been stripped.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) NoMonotonic() *PointerTimeValidator {
+	return t.AppendValidate(skipNil(noMonotonic))
+}
+
+// YearBetween adds a validate whether the year of input is between min and max inclusive.
+// If input is nil, returns nil.
+func (t *PointerTimeValidator) YearBetween(min, max int) *PointerTimeValidator {
+	return t.AppendValidate(skipNil(t.inLocation(yearBetween(min, max))))
+}
+
+func (t *PointerTimeValidator) in(value time.Time) time.Time {
+	return inLocation(value, t.loc)
+}
+
+func (t *PointerTimeValidator) date(value time.Time) time.Time {
+	return dateOf(value, t.loc)
+}
+
+func (t *PointerTimeValidator) inLocation(f Validate[time.Time]) Validate[time.Time] {
+	return func(value time.Time) error {
+		return f(t.in(value))
+	}
+}
+
+func inLocation(value time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return value
+	}
+	return value.In(loc)
+}
+
+// dateOf returns the midnight of value in loc.
+// If loc is nil, it is the midnight in the location of value.
+func dateOf(value time.Time, loc *time.Location) time.Time {
+	value = inLocation(value, loc)
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, value.Location())
+}
+
+func requireLocation(loc *time.Location) Validate[time.Time] {
+	return func(value time.Time) error {
+		if value.Location().String() != loc.String() {
+			return ErrInvalidLocation
+		}
+		return nil
+	}
+}
+
+func truncated(d time.Duration) Validate[time.Time] {
+	return func(value time.Time) error {
+		if !value.Truncate(d).Equal(value) {
+			return ErrNotTruncated
+		}
+		return nil
+	}
+}
+
+func noMonotonic(value time.Time) error {
+	// Round(0) strips only the monotonic reading, so == differs exactly when one is present.
+	if value != value.Round(0) {
+		return ErrMonotonic
+	}
+	return nil
+}
+
+func yearBetween(min, max int) Validate[time.Time] {
+	return func(value time.Time) error {
+		switch y := value.Year(); {
+		case y < min:
+			return ErrTooSmall
+		case y > max:
+			return ErrTooBig
+		}
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+package svalidator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestTime_Location(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	// 2024-06-01 20:00 UTC is 2024-06-02 05:00 in Tokyo.
+	late := time.Date(2024, 6, 1, 20, 0, 0, 0, time.UTC)
+	target := func() time.Time { return time.Date(2024, 6, 2, 0, 0, 0, 0, tokyo) }
+	// both are on June 2 in their own locations, but the midnight in Tokyo is before the midnight in UTC.
+	morningUTC := time.Date(2024, 6, 2, 5, 0, 0, 0, time.UTC)
+	morningTokyo := func() time.Time { return time.Date(2024, 6, 2, 8, 0, 0, 0, tokyo) }
+	type (
+		args struct {
+			validator *svalidator.TimeValidator
+			input     time.Time
+		}
+	)
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{"equal date in own location", args{svalidator.Time().EqualDate(target), late}, svalidator.ErrNotEqual},
+		{"equal date in tokyo", args{svalidator.Time().In(tokyo).EqualDate(target), late}, nil},
+		{"before date in own location", args{svalidator.Time().BeforeDate(target), late}, nil},
+		{"before date in tokyo", args{svalidator.Time().In(tokyo).BeforeDate(target), late}, svalidator.ErrTooBig},
+		{"after date in utc", args{svalidator.Time().In(time.UTC).EqOrAfterDate(target), late}, nil},
+		{"after date of local midnight", args{svalidator.Time().AfterDate(morningTokyo), morningUTC}, nil},
+		{"after date in tokyo", args{svalidator.Time().In(tokyo).AfterDate(morningTokyo), morningUTC}, svalidator.ErrTooSmall},
+		{"equal date of own calendar", args{svalidator.Time().EqualDate(morningTokyo), morningUTC}, nil},
+		{"weekday in tokyo", args{svalidator.Time().In(tokyo).Weekday(time.Sunday), late}, nil},
+		{"weekday set after location", args{svalidator.Time().Weekday(time.Sunday).In(tokyo), late}, nil},
+		{"weekday in own location", args{svalidator.Time().Weekday(time.Sunday), late}, svalidator.ErrNotAllowedWeekday},
+		{"require location", args{svalidator.Time().RequireLocation(tokyo), late.In(tokyo)}, nil},
+		{"require location error", args{svalidator.Time().RequireLocation(tokyo), late}, svalidator.ErrInvalidLocation},
+		{"require utc", args{svalidator.Time().RequireUTC(), late}, nil},
+		{"require utc error", args{svalidator.Time().RequireUTC(), late.In(tokyo)}, svalidator.ErrInvalidLocation},
+		{"truncated", args{svalidator.Time().Truncated(time.Minute), late}, nil},
+		{"not truncated", args{svalidator.Time().Truncated(time.Minute), late.Add(time.Second)}, svalidator.ErrNotTruncated},
+		{"no monotonic", args{svalidator.Time().NoMonotonic(), time.Now().Round(0)}, nil},
+		{"monotonic", args{svalidator.Time().NoMonotonic(), time.Now()}, svalidator.ErrMonotonic},
+		{"year between", args{svalidator.Time().YearBetween(1900, 2100), late}, nil},
+		{"year too small", args{svalidator.Time().YearBetween(1900, 2100), time.Time{}}, svalidator.ErrTooSmall},
+		{"year too big", args{svalidator.Time().YearBetween(1900, 2100), time.Date(2101, 1, 1, 0, 0, 0, 0, time.UTC)}, svalidator.ErrTooBig},
+		{"year in tokyo", args{svalidator.Time().In(tokyo).YearBetween(2025, 2100), time.Date(2024, 12, 31, 20, 0, 0, 0, time.UTC)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.validator.Validate(tt.args.input)
+			assertError(t, tt.want, err)
+		})
+	}
+}
+
+func TestPointerTime_Location(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	v := svalidator.PointerTime().In(tokyo).RequireUTC().Truncated(time.Second).NoMonotonic().YearBetween(2025, 2100)
+	assertError(t, nil, v.Validate(nil))
+	assertError(t, nil, v.Validate(pointer(time.Date(2024, 12, 31, 20, 0, 0, 0, time.UTC))))
+	assertError(t, svalidator.ErrTooSmall, v.Validate(pointer(time.Date(2024, 12, 31, 10, 0, 0, 0, time.UTC))))
+	assertError(t, svalidator.ErrInvalidLocation, v.Validate(pointer(time.Date(2025, 6, 1, 0, 0, 0, 0, tokyo))))
+}
@@ -1,27 +1,43 @@
 package svalidator
 
+import "context"
+
 // New create Validator from Validate funcs.
 func New[T any](funcs ...Validate[T]) *Validator[T] {
-	return &Validator[T]{
-		validFuncs: funcs,
-	}
+	return new(Validator[T]).AppendValidate(funcs...)
+}
+
+// NewContext create Validator from ContextValidate funcs.
+func NewContext[T any](funcs ...ContextValidate[T]) *Validator[T] {
+	return new(Validator[T]).AppendContextValidate(funcs...)
 }
 
 type AnyValidator interface {
-	validateAny(v any) error
+	validateAny(ctx context.Context, v any) error
 }
 
 // Validator is a validator for generics type.
 type Validator[T any] struct {
-	validFuncs []Validate[T]
+	validFuncs []ContextValidate[T]
+	groups     []Group
 }
 
 type Validate[T any] func(value T) error
 
+// ContextValidate is a Validate func which receives the context of the validation.
+type ContextValidate[T any] func(ctx context.Context, value T) error
+
 // Validate validates value.
 func (v *Validator[T]) Validate(value T) error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+// ValidateContext validates value with ctx.
+func (v *Validator[T]) ValidateContext(ctx context.Context, value T) error {
 	for _, f := range v.validFuncs {
-		if err := f(value); err != nil {
+		if err := f(ctx, value); isAbort(err) {
+			return err
+		} else if err != nil && !warn(ctx, err) {
 			return &ErrValidate{Err: err, Input: value}
 		}
 	}
@@ -30,10 +46,31 @@ func (v *Validator[T]) Validate(value T) error {
 
 // AppendValidate appends Validate func.
 func (v *Validator[T]) AppendValidate(funcs ...Validate[T]) *Validator[T] {
+	for _, f := range funcs {
+		f := f
+		v.validFuncs = append(v.validFuncs, func(_ context.Context, value T) error {
+			return f(value)
+		})
+	}
+	return v
+}
+
+// AppendContextValidate appends ContextValidate func.
+func (v *Validator[T]) AppendContextValidate(funcs ...ContextValidate[T]) *Validator[T] {
 	v.validFuncs = append(v.validFuncs, funcs...)
 	return v
 }
 
-func (v *Validator[T]) validateAny(anyValue any) error {
-	return v.Validate(anyValue.(T))
+func (v *Validator[T]) validateAny(ctx context.Context, anyValue any) error {
+	return v.ValidateContext(ctx, anyValue.(T))
+}
+
+// validateWith validates value by v, passing ctx when v supports it.
+func validateWith[T any](ctx context.Context, v ValueValidator[T], value T) error {
+	if cv, ok := v.(interface {
+		ValidateContext(context.Context, T) error
+	}); ok {
+		return cv.ValidateContext(ctx, value)
+	}
+	return v.Validate(value)
 }
@@ -0,0 +1,135 @@
+package svalidator
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Severity is the severity of ErrObjectField.
+type Severity int
+
+const (
+	// SeverityError fails the validation. This is the default.
+	SeverityError Severity = iota
+	// SeverityWarning is advisory and does not fail the validation.
+	SeverityWarning
+)
+
+// ErrWarning marks Err returned by a Validate func as a warning.
+// A warning does not stop the validation, and is reported only by Check.
+type ErrWarning struct {
+	Err error
+}
+
+// AsWarning returns err as a warning. If err is nil, AsWarning returns nil.
+func AsWarning(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ErrWarning{Err: err}
+}
+
+func (e *ErrWarning) Error() string {
+	return "warning: " + e.Err.Error()
+}
+
+func (e *ErrWarning) Unwrap() error {
+	return e.Err
+}
+
+// Warning returns a validator which reports every error of inner as a warning.
+func Warning[T any](inner ValueValidator[T]) *Validator[T] {
+	v := NewContext(func(ctx context.Context, value T) error {
+		err := unwrapValidate(validateWith(ctx, inner, value))
+		if isAbort(err) {
+			return err
+		}
+		return AsWarning(err)
+	})
+	v.groups = groupsOf(inner)
+	return v
+}
+
+// Result is the result of Check.
+type Result struct {
+	// Err is the validation error. It is nil if only warnings fire.
+	Err error
+	// Warnings are the warnings by field, whose severity is SeverityWarning.
+	Warnings ErrObject
+}
+
+// Check validates value by v, and returns the error and the warnings separately.
+func Check[T any](v ValueValidator[T], value T) Result {
+	return CheckContext(context.Background(), v, value)
+}
+
+// CheckContext is Check with ctx.
+func CheckContext[T any](ctx context.Context, v ValueValidator[T], value T) Result {
+	c := &warningCollector{}
+	err := validateWith(context.WithValue(ctx, warningScopeKey{}, &warningScope{collector: c}), v, value)
+	setPointers(c.fields, "")
+	return Result{Err: err, Warnings: c.fields}
+}
+
+type warningCollector struct {
+	mu     sync.Mutex
+	fields ErrObject
+}
+
+// warningScope is the field path of the value being validated by Check.
+type warningScope struct {
+	collector *warningCollector
+	path      []string
+}
+
+type warningScopeKey struct{}
+
+// withField returns ctx for validating field of the current value.
+func withField(ctx context.Context, field string) context.Context {
+	scope, ok := ctx.Value(warningScopeKey{}).(*warningScope)
+	if !ok {
+		return ctx
+	}
+	path := append(scope.path[:len(scope.path):len(scope.path)], field)
+	return context.WithValue(ctx, warningScopeKey{}, &warningScope{collector: scope.collector, path: path})
+}
+
+// warn records err as a warning if err is ErrWarning. It reports whether err is recorded.
+func warn(ctx context.Context, err error) bool {
+	var w *ErrWarning
+	if !errors.As(err, &w) {
+		return false
+	}
+	scope, ok := ctx.Value(warningScopeKey{}).(*warningScope)
+	if !ok {
+		return true
+	}
+	markWarning(w.Err)
+	warning := w.Err
+	for i := len(scope.path) - 1; i >= 0; i-- {
+		warning = ErrObject{&ErrObjectField{Field: scope.path[i], Err: warning, Severity: SeverityWarning}}
+	}
+	fields, ok := warning.(ErrObject)
+	if !ok {
+		fields = ErrObject{&ErrObjectField{Err: warning, Severity: SeverityWarning}}
+	}
+
+	scope.collector.mu.Lock()
+	defer scope.collector.mu.Unlock()
+	for _, f := range fields {
+		scope.collector.fields = scope.collector.fields.add(f)
+	}
+	return true
+}
+
+func markWarning(err error) {
+	fields, ok := unwrapValidate(err).(ErrObject)
+	if !ok {
+		return
+	}
+	for _, f := range fields {
+		f.Severity = SeverityWarning
+		markWarning(f.Err)
+	}
+}
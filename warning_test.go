@@ -0,0 +1,91 @@
+package svalidator_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/komem3/svalidator"
+)
+
+func TestCheck(t *testing.T) {
+	type Item struct {
+		Note string
+	}
+	type Post struct {
+		Title       string
+		Description string
+		PublishAt   time.Time
+		Items       []Item
+	}
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	v := svalidator.Object(svalidator.ValidatorMap[Post]{
+		"Title":       svalidator.String().Required(),
+		"Description": svalidator.Warning[string](svalidator.String().Max(10)),
+		"PublishAt": svalidator.Time().AppendValidate(func(value time.Time) error {
+			if value.After(now.AddDate(1, 0, 0)) {
+				return svalidator.AsWarning(svalidator.ErrTooBig)
+			}
+			return nil
+		}),
+		"Items": svalidator.Slice[Item](svalidator.Object(svalidator.ValidatorMap[Item]{
+			"Note": svalidator.Warning[string](svalidator.String().Required()),
+		})),
+	})
+
+	post := Post{
+		Title:       "title",
+		Description: "unusually long description",
+		PublishAt:   now.AddDate(2, 0, 0),
+		Items:       []Item{{Note: "a"}, {}},
+	}
+	if err := v.Validate(post); err != nil {
+		t.Fatalf("warnings fail validation: %v", err)
+	}
+
+	result := svalidator.Check[Post](v, post)
+	assertError(t, nil, result.Err)
+	if got, want := result.Warnings.Fields(), []string{"Description", "PublishAt", "Items.1.Note"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if !result.Warnings.HasCode("Items.1.Note", svalidator.ErrEmpty) {
+		t.Errorf("unexpected warnings %v", result.Warnings)
+	}
+	var field *svalidator.ErrObjectField
+	if !errors.As(result.Warnings.For("Items.1")[0], &field) || field.Severity != svalidator.SeverityWarning || field.Pointer != "/Items/1/Note" {
+		t.Errorf("unexpected field %+v", field)
+	}
+
+	post.Title = ""
+	result = svalidator.Check[Post](v, post)
+	assertError(t, svalidator.ErrObject{&svalidator.ErrObjectField{Field: "Title", Err: svalidator.ErrEmpty}}, result.Err)
+	if len(result.Warnings.Fields()) != 3 {
+		t.Errorf("unexpected warnings %v", result.Warnings)
+	}
+	var oerr svalidator.ErrObject
+	if !errors.As(result.Err, &oerr) || len(oerr) != 1 || oerr[0].Severity != svalidator.SeverityError {
+		t.Errorf("unexpected error %v", result.Err)
+	}
+}
+
+func TestCheck_Value(t *testing.T) {
+	v := svalidator.String().Trim().AppendValidate(func(value string) error {
+		if len(value) > 3 {
+			return svalidator.AsWarning(svalidator.ErrTooBig)
+		}
+		return nil
+	}).Required()
+
+	result := svalidator.Check[string](v, " abcd ")
+	assertError(t, nil, result.Err)
+	if len(result.Warnings) != 1 || !errors.Is(result.Warnings[0].Err, svalidator.ErrTooBig) {
+		t.Errorf("unexpected warnings %v", result.Warnings)
+	}
+
+	result = svalidator.Check[string](v, " ")
+	assertError(t, svalidator.ErrEmpty, result.Err)
+	if result.Warnings != nil {
+		t.Errorf("unexpected warnings %v", result.Warnings)
+	}
+}